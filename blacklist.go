@@ -0,0 +1,176 @@
+package validator
+
+import (
+	"bufio"
+	_ "embed"
+	"hash/fnv"
+	"io"
+	"math"
+	"os"
+	"strings"
+)
+
+//go:embed conf/password_black_list.txt
+var defaultPasswordBlackList string
+
+func newDefaultBlackList() BlackListProvider {
+	list := newMemoryBlackList()
+	_ = list.addReader(strings.NewReader(defaultPasswordBlackList))
+
+	return list
+}
+
+func newMemoryBlackList() *memoryBlackList {
+	return &memoryBlackList{
+		values: make(map[string]empty),
+	}
+}
+
+// NewMemoryBlackList returns a black list with the values
+func NewMemoryBlackList(values ...string) BlackListProvider {
+	list := newMemoryBlackList()
+	list.add(values...)
+
+	return list
+}
+
+// NewReaderBlackList returns a black list with a value by line of the reader
+func NewReaderBlackList(reader io.Reader) (BlackListProvider, error) {
+	list := newMemoryBlackList()
+	if err := list.addReader(reader); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// NewFileBlackList returns a black list with a value by line of the file
+func NewFileBlackList(path string) (BlackListProvider, error) {
+	list := newMemoryBlackList()
+	if err := list.addFile(path); err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (l *memoryBlackList) add(values ...string) {
+	for _, value := range values {
+		if value = normalizeBlackListValue(value); value != "" {
+			l.values[value] = empty{}
+		}
+	}
+}
+
+func (l *memoryBlackList) addReader(reader io.Reader) error {
+	return readBlackList(reader, func(value string) {
+		l.add(value)
+	})
+}
+
+func (l *memoryBlackList) addFile(path string) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return l.addReader(file)
+}
+
+func (l *memoryBlackList) Contains(value string) bool {
+	_, ok := l.values[normalizeBlackListValue(value)]
+	return ok
+}
+
+// NewBloomBlackList returns a black list backed by a bloom filter, for lists with millions of values,
+// where the expected number of values and the false positive rate define the size of the filter
+func NewBloomBlackList(reader io.Reader, expected int, falsePositiveRate float64) (BlackListProvider, error) {
+	if expected < 1 {
+		expected = 1
+	}
+
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = constBloomFalsePositiveRate
+	}
+
+	// the bits are stored on words of 64 bits, so the filter uses at least one word
+	size := uint64(math.Max(64, math.Ceil(-float64(expected)*math.Log(falsePositiveRate)/(math.Ln2*math.Ln2))))
+	hashes := uint64(math.Max(1, math.Round(float64(size)/float64(expected)*math.Ln2)))
+
+	list := &bloomBlackList{
+		bits:   make([]uint64, (size+63)/64),
+		size:   size,
+		hashes: hashes,
+	}
+
+	err := readBlackList(reader, func(value string) {
+		if value = normalizeBlackListValue(value); value != "" {
+			list.add(value)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+func (l *bloomBlackList) positions(value string) []uint64 {
+	first := fnv.New64a()
+	first.Write([]byte(value))
+	second := fnv.New64()
+	second.Write([]byte(value))
+
+	// enhanced double hashing with two hashes, where the step changes on each position,
+	// so the positions do not repeat when the step shares a factor with the size
+	position, step := mixHash(first.Sum64()), mixHash(second.Sum64())
+
+	positions := make([]uint64, l.hashes)
+	for i := uint64(0); i < l.hashes; i++ {
+		positions[i] = position % l.size
+		position += step
+		step += i + 1
+	}
+
+	return positions
+}
+
+// mixHash spreads the bits of a fnv hash, that are close for close values
+func mixHash(hash uint64) uint64 {
+	hash ^= hash >> 33
+	hash *= 0xff51afd7ed558ccd
+	hash ^= hash >> 33
+	hash *= 0xc4ceb9fe1a85ec53
+	hash ^= hash >> 33
+	return hash
+}
+
+func (l *bloomBlackList) add(value string) {
+	for _, position := range l.positions(value) {
+		l.bits[position/64] |= 1 << (position % 64)
+	}
+}
+
+func (l *bloomBlackList) Contains(value string) bool {
+	for _, position := range l.positions(normalizeBlackListValue(value)) {
+		if l.bits[position/64]&(1<<(position%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+func readBlackList(reader io.Reader, add func(value string)) error {
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		add(scanner.Text())
+	}
+
+	return scanner.Err()
+}
+
+func normalizeBlackListValue(value string) string {
+	return strings.ToLower(strings.TrimSpace(value))
+}
@@ -0,0 +1,148 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestBlackListProviders(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "black_list.txt")
+	if err := os.WriteFile(path, []byte("Secret\nletmein\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	reader, err := NewReaderBlackList(strings.NewReader("Secret\nletmein\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file, err := NewFileBlackList(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bloom, err := NewBloomBlackList(strings.NewReader("Secret\nletmein\n"), 2, 0.001)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	providers := map[string]BlackListProvider{
+		"memory": NewMemoryBlackList("Secret", "letmein"),
+		"reader": reader,
+		"file":   file,
+		"bloom":  bloom,
+	}
+
+	for name, provider := range providers {
+		for _, value := range []string{"secret", " SECRET ", "letmein"} {
+			if !provider.Contains(value) {
+				t.Errorf("%s: expected the value %q on the black list", name, value)
+			}
+		}
+
+		if provider.Contains("Tr0ub4dor&3xyz") {
+			t.Errorf("%s: unexpected value on the black list", name)
+		}
+	}
+
+	if _, err := NewFileBlackList(filepath.Join(t.TempDir(), "missing.txt")); err == nil {
+		t.Errorf("expected an error for the missing file")
+	}
+}
+
+func TestBloomBlackListFalsePositives(t *testing.T) {
+	values := make([]string, 0, 1000)
+	for i := 0; i < 1000; i++ {
+		values = append(values, "password"+strconv.Itoa(i))
+	}
+
+	bloom, err := NewBloomBlackList(strings.NewReader(strings.Join(values, "\n")), len(values), 0.01)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, value := range values {
+		if !bloom.Contains(value) {
+			t.Errorf("expected the value %q on the black list", value)
+		}
+	}
+
+	falsePositives := 0
+	for i := 0; i < 10000; i++ {
+		if bloom.Contains("other" + strconv.Itoa(i)) {
+			falsePositives++
+		}
+	}
+
+	if falsePositives > 200 {
+		t.Errorf("expected a false positive rate near 1%%, got %d false positives on 10000 values", falsePositives)
+	}
+}
+
+func TestSetPasswordBlackList(t *testing.T) {
+	type example struct {
+		Password string `validate:"password=medium"`
+	}
+
+	validator := NewValidator()
+
+	// the default black list is embedded
+	errs := validator.Validate(&example{Password: "password1"})
+	if len(errs) == 0 || !isErrorCode(errs[0], ErrorPasswordBlackList) {
+		t.Errorf("expected the error [%s], got %v", ErrorPasswordBlackList, errs)
+	}
+
+	validator.SetPasswordBlackList(NewMemoryBlackList("Tr0ub4dor&3xyz"))
+
+	errs = validator.Validate(&example{Password: "Tr0ub4dor&3xyz"})
+	if len(errs) == 0 || !isErrorCode(errs[0], ErrorPasswordBlackList) {
+		t.Errorf("expected the error [%s], got %v", ErrorPasswordBlackList, errs)
+	}
+}
+
+func TestPasswordUserContext(t *testing.T) {
+	type example struct {
+		Username string `json:"username"`
+		Email    string `json:"email"`
+		Password string `validate:"password=medium;{username};{email};{missing}"`
+	}
+
+	tests := []struct {
+		name     string
+		obj      *example
+		expected bool
+	}{
+		{"username", &example{Username: "joaosoft", Email: "joao@mail.com", Password: "JoaoSoft#2077xyz"}, true},
+		{"email", &example{Username: "maria", Email: "joao@mail.com", Password: "x9joao@mail.comK"}, true},
+		{"valid", &example{Username: "maria", Email: "maria@mail.com", Password: "Tr0ub4dor&3xyz"}, false},
+	}
+
+	for _, test := range tests {
+		errs := NewValidator().Validate(test.obj)
+
+		found := false
+		for _, err := range errs {
+			if isErrorCode(err, ErrorPasswordUserContext) {
+				found = true
+			}
+		}
+
+		if found != test.expected {
+			t.Errorf("%s: expected the error [%s] %t, got %v", test.name, ErrorPasswordUserContext, test.expected, errs)
+		}
+	}
+
+	strength, err := NewValidator().EvaluatePassword("Maria1234567!", "", "maria")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+
+	for _, rule := range strength.Rules {
+		if rule.Name == constPasswordCheckUserContext && rule.Passed {
+			t.Errorf("expected the rule %s to fail", constPasswordCheckUserContext)
+		}
+	}
+}
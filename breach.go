@@ -0,0 +1,243 @@
+package validator
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// NewDirectoryBreachProvider returns a provider with a file by hash prefix on the directory,
+// named by the prefix, with a SUFFIX:COUNT by line
+func NewDirectoryBreachProvider(path string) BreachProvider {
+	return &directoryBreachProvider{
+		path: path,
+	}
+}
+
+// NewFileBreachProvider returns a provider with a HASH:COUNT by line, ordered by hash
+func NewFileBreachProvider(path string) BreachProvider {
+	return &fileBreachProvider{
+		path: path,
+	}
+}
+
+// NewHttpBreachProvider returns a provider that gets the hash prefix from the url, like the range api of have i been pwned,
+// the default client has a timeout
+func NewHttpBreachProvider(url string, client *http.Client) BreachProvider {
+	return NewHttpBreachProviderWithContext(context.Background(), url, client)
+}
+
+// NewHttpBreachProviderWithContext returns a http provider whose requests are canceled with the context
+func NewHttpBreachProviderWithContext(ctx context.Context, url string, client *http.Client) BreachProvider {
+	if client == nil {
+		client = &http.Client{Timeout: constBreachHttpTimeout}
+	}
+
+	return &httpBreachProvider{
+		ctx:    ctx,
+		url:    strings.TrimSuffix(url, "/"),
+		client: client,
+	}
+}
+
+// NewCachedBreachProvider keeps the last ranges of the provider, until the size
+func NewCachedBreachProvider(provider BreachProvider, size int) BreachProvider {
+	if size <= 0 {
+		size = constBreachCacheSize
+	}
+
+	return &cachedBreachProvider{
+		provider: provider,
+		size:     size,
+		cache:    make(map[string]map[string]int),
+	}
+}
+
+func (p *directoryBreachProvider) Range(prefix string) (map[string]int, error) {
+	for _, name := range []string{prefix, prefix + constBreachFileExtension, strings.ToLower(prefix), strings.ToLower(prefix) + constBreachFileExtension} {
+		file, err := os.Open(filepath.Join(p.path, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		hashes, err := readBreachRange(file, prefix)
+		file.Close()
+
+		return hashes, err
+	}
+
+	return make(map[string]int), nil
+}
+
+func (p *fileBreachProvider) Range(prefix string) (map[string]int, error) {
+	file, err := os.Open(p.path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+	size := info.Size()
+
+	// binary search of the first line of the prefix
+	low, high := int64(0), size
+	for low < high {
+		middle := low + (high-low)/2
+
+		_, line, err := readLineAt(file, size, middle)
+		if err == io.EOF || strings.ToUpper(line) >= prefix {
+			high = middle
+		} else if err != nil {
+			return nil, err
+		} else {
+			low = middle + 1
+		}
+	}
+
+	start, _, err := readLineAt(file, size, low)
+	if err == io.EOF {
+		return make(map[string]int), nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return readBreachRange(io.NewSectionReader(file, start, size-start), prefix)
+}
+
+// readLineAt reads the first line that starts on the offset or after it
+func readLineAt(file *os.File, size int64, offset int64) (int64, string, error) {
+	start := offset
+
+	if offset > 0 {
+		skipped, err := bufio.NewReader(io.NewSectionReader(file, offset-1, size-offset+1)).ReadString('\n')
+		if err != nil {
+			return size, "", io.EOF
+		}
+		start = offset - 1 + int64(len(skipped))
+	}
+
+	line, err := bufio.NewReader(io.NewSectionReader(file, start, size-start)).ReadString('\n')
+	if line == "" && err != nil {
+		return size, "", io.EOF
+	}
+
+	return start, strings.TrimSpace(line), nil
+}
+
+func (p *httpBreachProvider) Range(prefix string) (map[string]int, error) {
+	request, err := http.NewRequestWithContext(p.ctx, http.MethodGet, fmt.Sprintf("%s/%s", p.url, prefix), nil)
+	if err != nil {
+		return nil, err
+	}
+	request.Header.Set("Add-Padding", "true")
+
+	response, err := p.client.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	switch response.StatusCode {
+	case http.StatusOK:
+		return readBreachRange(response.Body, prefix)
+	case http.StatusNotFound:
+		return make(map[string]int), nil
+	default:
+		return nil, formatError(ErrorBreachResponse, response.StatusCode)
+	}
+}
+
+func (p *cachedBreachProvider) Range(prefix string) (map[string]int, error) {
+	p.mux.Lock()
+	hashes, ok := p.cache[prefix]
+	p.mux.Unlock()
+
+	if ok {
+		return hashes, nil
+	}
+
+	hashes, err := p.provider.Range(prefix)
+	if err != nil {
+		return nil, err
+	}
+
+	p.mux.Lock()
+	if len(p.cache) >= p.size {
+		p.cache = make(map[string]map[string]int)
+	}
+	p.cache[prefix] = hashes
+	p.mux.Unlock()
+
+	return hashes, nil
+}
+
+// readBreachRange reads the suffixes of the prefix, with lines SUFFIX:COUNT or the full HASH:COUNT
+func readBreachRange(reader io.Reader, prefix string) (map[string]int, error) {
+	hashes := make(map[string]int)
+
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		split := strings.SplitN(strings.TrimSpace(scanner.Text()), constBreachCountSeparator, 2)
+		hash := strings.ToUpper(split[0])
+		if hash == "" {
+			continue
+		}
+
+		if len(hash) == constBreachHashLength {
+			if hash[:constBreachPrefixLength] > prefix {
+				break
+			}
+
+			if !strings.HasPrefix(hash, prefix) {
+				continue
+			}
+			hash = hash[constBreachPrefixLength:]
+		}
+
+		count := 1
+		if len(split) > 1 {
+			var err error
+			if count, err = strconv.Atoi(strings.TrimSpace(split[1])); err != nil {
+				return nil, err
+			}
+		}
+
+		// the padding lines have no count
+		if count > 0 {
+			hashes[hash] = count
+		}
+	}
+
+	return hashes, scanner.Err()
+}
+
+// breached returns how many times the password was found, sending only the prefix of the hash to the provider
+func (p *password) breached(value string) (int, error) {
+	if p.breach == nil {
+		return 0, ErrorBreachProvider
+	}
+
+	sum := sha1.Sum([]byte(value))
+	hash := strings.ToUpper(hex.EncodeToString(sum[:]))
+
+	hashes, err := p.breach.Range(hash[:constBreachPrefixLength])
+	if err != nil {
+		return 0, err
+	}
+
+	return hashes[hash[constBreachPrefixLength:]], nil
+}
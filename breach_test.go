@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+// sha-1 of "password" is 5BAA61E4C9B93F3F0682250B6CF8331B7EE68FD8
+const (
+	breachPrefix = "5BAA6"
+	breachSuffix = "1E4C9B93F3F0682250B6CF8331B7EE68FD8"
+)
+
+type countingBreachProvider struct {
+	provider BreachProvider
+	calls    int
+}
+
+func (p *countingBreachProvider) Range(prefix string) (map[string]int, error) {
+	p.calls++
+	return p.provider.Range(prefix)
+}
+
+func TestBreachProviders(t *testing.T) {
+	dir := t.TempDir()
+
+	if err := os.WriteFile(filepath.Join(dir, breachPrefix+".txt"), []byte("0018A45C4D1DEF81644B54AB7F969B88D65:1\n"+breachSuffix+":10\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	hashes := strings.Join([]string{
+		"0000000A8DAE4228F821FB418F59826079BF368:2",
+		breachPrefix + "0018A45C4D1DEF81644B54AB7F969B88D65:1",
+		breachPrefix + breachSuffix + ":20",
+		"FFFFFFF8A0382AA9C8D9536EFBA77F261815334D:3",
+	}, "\n")
+	if err := os.WriteFile(filepath.Join(dir, "hashes.txt"), []byte(hashes+"\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/range/"+breachPrefix {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		// the padding lines have the count 0
+		w.Write([]byte(breachSuffix + ":30\r\n0018A45C4D1DEF81644B54AB7F969B88D66:0\r\n"))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		provider BreachProvider
+		count    int
+	}{
+		{"directory", NewDirectoryBreachProvider(dir), 10},
+		{"file", NewFileBreachProvider(filepath.Join(dir, "hashes.txt")), 20},
+		{"http", NewHttpBreachProvider(server.URL+"/range/", nil), 30},
+	}
+
+	for _, test := range tests {
+		validator := NewValidator().SetBreachProvider(test.provider)
+
+		count, err := validator.IsPasswordBreached("password")
+		if err != nil || count != test.count {
+			t.Errorf("%s: expected the count %d, got %d (%v)", test.name, test.count, count, err)
+		}
+
+		count, err = validator.IsPasswordBreached("Tr0ub4dor&3xyz")
+		if err != nil || count != 0 {
+			t.Errorf("%s: expected the count 0, got %d (%v)", test.name, count, err)
+		}
+	}
+}
+
+func TestCachedBreachProvider(t *testing.T) {
+	provider := &countingBreachProvider{provider: NewDirectoryBreachProvider(t.TempDir())}
+	validator := NewValidator().SetBreachProvider(NewCachedBreachProvider(provider, 0))
+
+	for i := 0; i < 3; i++ {
+		if _, err := validator.IsPasswordBreached("password"); err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+	}
+
+	if provider.calls != 1 {
+		t.Errorf("expected 1 call to the provider, got %d", provider.calls)
+	}
+}
+
+func TestPasswordBreach(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, breachPrefix), []byte(breachSuffix+":10\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type example struct {
+		Password string `validate:"password=breach"`
+	}
+
+	errs := NewValidator().Validate(&example{Password: "password"})
+	if len(errs) == 0 || !isErrorCode(errs[len(errs)-1], ErrorBreachProvider) {
+		t.Errorf("expected the error [%s], got %v", ErrorBreachProvider, errs)
+	}
+
+	validator := NewValidator().SetBreachProvider(NewDirectoryBreachProvider(dir))
+
+	errs = validator.Validate(&example{Password: "password"})
+	if len(errs) == 0 || !isErrorCode(errs[len(errs)-1], ErrorPasswordBreached) {
+		t.Errorf("expected the error [%s], got %v", ErrorPasswordBreached, errs)
+	}
+
+	if errs = validator.Validate(&example{Password: "Tr0ub4dor&3xyz"}); len(errs) != 0 {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
+
+func TestHttpBreachProviderDefaultTimeout(t *testing.T) {
+	provider := NewHttpBreachProvider("http://localhost", nil).(*httpBreachProvider)
+
+	if provider.client == http.DefaultClient || provider.client.Timeout != constBreachHttpTimeout {
+		t.Errorf("expected a client with the timeout %s", constBreachHttpTimeout)
+	}
+}
+
+func TestHttpBreachProviderContext(t *testing.T) {
+	done := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-done:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(done)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	v := NewValidator().SetBreachProvider(NewHttpBreachProviderWithContext(ctx, server.URL, nil))
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := v.IsPasswordBreached("password")
+		result <- err
+	}()
+
+	select {
+	case err := <-result:
+		if err == nil {
+			t.Error("expected an error when the context is canceled")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("the request was not canceled with the context")
+	}
+}
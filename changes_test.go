@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+type changesExample struct {
+	Name  string   `validate:"set-trim, set-upper"`
+	Email string   `validate:"set-lower, not-empty"`
+	Tags  []string `validate:"item:set-trim"`
+	Age   int      `validate:"max=10"`
+}
+
+func TestNormalize(t *testing.T) {
+	obj := &changesExample{Name: " joao ", Email: "", Tags: []string{" a "}, Age: 20}
+
+	changes, errs := NewValidator().Normalize(obj)
+	if len(errs) != 0 {
+		t.Fatalf("the validations do not run on normalize, got %v", errs)
+	}
+
+	expected := []*Change{
+		{Path: "Name", Tag: "set-trim", Old: " joao ", New: "joao"},
+		{Path: "Name", Tag: "set-upper", Old: "joao", New: "JOAO"},
+		{Path: "Tags", Tag: "set-trim", Old: []string{" a "}, New: []string{"a"}},
+	}
+
+	if len(changes) != len(expected) {
+		t.Fatalf("expected %d changes, got %d", len(expected), len(changes))
+	}
+
+	for i, change := range changes {
+		if !reflect.DeepEqual(change, expected[i]) {
+			t.Errorf("expected the change %+v, got %+v", *expected[i], *change)
+		}
+	}
+
+	if obj.Name != "JOAO" || obj.Tags[0] != "a" {
+		t.Errorf("the object was not normalized, got %+v", obj)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	obj := &changesExample{Name: " joao ", Email: "MAIL", Tags: []string{" a "}, Age: 20}
+
+	result := NewValidator().SetValidateAll(true).ValidateWithResult(obj, DryRun())
+	if len(result.Errors) != 1 {
+		t.Errorf("expected 1 error, got %v", result.Errors)
+	}
+
+	if len(result.Changes) != 4 {
+		t.Errorf("expected 4 changes, got %+v", result.Changes)
+	}
+
+	expected := &changesExample{Name: " joao ", Email: "MAIL", Tags: []string{" a "}, Age: 20}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("the dry run changed the object, got %+v", obj)
+	}
+}
+
+func TestClone(t *testing.T) {
+	obj := &changesExample{Name: "a", Tags: []string{"b"}}
+
+	clone := _cloneToPointer(obj).(*changesExample)
+	clone.Name = "c"
+	clone.Tags[0] = "d"
+
+	if obj.Name != "a" || obj.Tags[0] != "b" {
+		t.Errorf("the clone changed the original, got %+v", obj)
+	}
+}
+
+func TestSensitiveChanges(t *testing.T) {
+	type example struct {
+		Name      string `validate:"set-trim"`
+		Password  string `validate:"set-sha256"`
+		Token     string `validate:"set-hmac=key"`
+		Email     string `validate:"set-pseudonym=key;email"`
+		Reference string `validate:"set-reverse"`
+	}
+
+	obj := &example{Name: " joao ", Password: "secret", Token: "token", Email: "joao@mail.com", Reference: "abc"}
+
+	changes, errs := NewValidator().
+		AddHmacKey("key", []byte("key")).
+		AddHasher("reverse", &reverseHasher{}).
+		Normalize(obj)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if len(changes) != 5 {
+		t.Fatalf("expected 5 changes, got %d", len(changes))
+	}
+
+	if changes[0].Old != " joao " {
+		t.Errorf("expected the old value on the change %+v", *changes[0])
+	}
+
+	for _, change := range changes[1:] {
+		if change.Old != nil || change.New == nil {
+			t.Errorf("expected the change without the old value, got %+v", *change)
+		}
+	}
+}
@@ -0,0 +1,274 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// _validateIdentifier checks the text values with the check, ignoring the empty values
+func (v *Validator) _validateIdentifier(validationData *ValidationData, check func(value string) error) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil || obj.Kind() != reflect.String || obj.String() == "" {
+		return rtnErrs
+	}
+
+	// the value can be only separators
+	value := cleanIdentifier(obj.String())
+	if value == "" {
+		rtnErrs = append(rtnErrs, ErrorInvalidFormat)
+		return rtnErrs
+	}
+
+	if err := check(value); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
+
+// cleanIdentifier removes the spaces and the hyphens used to group the characters
+func cleanIdentifier(value string) string {
+	return strings.ToUpper(strings.NewReplacer(" ", "", "-", "").Replace(strings.TrimSpace(value)))
+}
+
+func isDigits(value string) bool {
+	for _, ch := range value {
+		if ch < '0' || ch > '9' {
+			return false
+		}
+	}
+
+	return value != ""
+}
+
+func checkLuhn(number string) error {
+	if len(number) < 2 || !isDigits(number) {
+		return ErrorInvalidFormat
+	}
+
+	sum := 0
+	double := false
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if double {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	if sum%10 != 0 {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func detectCardBrand(number string) *cardBrand {
+	for _, brand := range cardBrands {
+		for _, prefix := range brand.prefixes {
+			digits := len(strconv.Itoa(prefix[0]))
+			if len(number) < digits {
+				continue
+			}
+
+			value, err := strconv.Atoi(number[:digits])
+			if err == nil && value >= prefix[0] && value <= prefix[1] {
+				return brand
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkCreditCard checks the number and the brand, when there are allowed brands
+func checkCreditCard(number string, brands []string) error {
+	if !isDigits(number) {
+		return ErrorInvalidFormat
+	}
+
+	if len(number) < constCardMinLength || len(number) > constCardMaxLength {
+		return formatError(ErrorInvalidLength, len(number))
+	}
+
+	if err := checkLuhn(number); err != nil {
+		return err
+	}
+
+	var name string
+	if brand := detectCardBrand(number); brand != nil {
+		name = brand.name
+
+		validLength := false
+		for _, length := range brand.lengths {
+			validLength = validLength || length == len(number)
+		}
+
+		if !validLength {
+			return formatError(ErrorInvalidLength, len(number))
+		}
+	}
+
+	if len(brands) == 0 {
+		return nil
+	}
+
+	for _, allowed := range brands {
+		if name != "" && strings.EqualFold(strings.TrimSpace(allowed), name) {
+			return nil
+		}
+	}
+
+	return formatError(ErrorInvalidCardBrand, name)
+}
+
+func checkIban(iban string) error {
+	if matched, _ := regexp.MatchString(constRegexForIban, iban); !matched {
+		return ErrorInvalidFormat
+	}
+
+	country := iban[:2]
+	length, ok := ibanLengths[country]
+	if !ok {
+		return formatError(ErrorInvalidCountry, country)
+	}
+
+	if len(iban) != length {
+		return formatError(ErrorInvalidLength, len(iban))
+	}
+
+	// mod 97 of the number with the country and check digits at the end
+	remainder := 0
+	for _, ch := range iban[4:] + iban[:4] {
+		if ch >= '0' && ch <= '9' {
+			remainder = (remainder*10 + int(ch-'0')) % 97
+		} else {
+			remainder = (remainder*100 + int(ch-'A'+10)) % 97
+		}
+	}
+
+	if remainder != 1 {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func checkIsbn10(isbn string) error {
+	if len(isbn) != 10 {
+		return formatError(ErrorInvalidLength, len(isbn))
+	}
+
+	sum := 0
+	for i, ch := range isbn {
+		var digit int
+		switch {
+		case ch >= '0' && ch <= '9':
+			digit = int(ch - '0')
+		case ch == 'X' && i == 9:
+			digit = 10
+		default:
+			return ErrorInvalidFormat
+		}
+
+		sum += (10 - i) * digit
+	}
+
+	if sum%11 != 0 {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func checkIsbn13(isbn string) error {
+	if len(isbn) == 13 && !strings.HasPrefix(isbn, "978") && !strings.HasPrefix(isbn, "979") {
+		return ErrorInvalidFormat
+	}
+
+	return checkGtin(isbn, 13)
+}
+
+func checkIsbn(isbn string) error {
+	if len(isbn) == 10 {
+		return checkIsbn10(isbn)
+	}
+
+	return checkIsbn13(isbn)
+}
+
+// checkGtin checks the ean and upc codes, with the weights 3 and 1 from the right
+func checkGtin(code string, lengths ...int) error {
+	validLength := false
+	for _, length := range lengths {
+		validLength = validLength || len(code) == length
+	}
+
+	if !validLength {
+		return formatError(ErrorInvalidLength, len(code))
+	}
+
+	if !isDigits(code) {
+		return ErrorInvalidFormat
+	}
+
+	sum := 0
+	for i := len(code) - 2; i >= 0; i-- {
+		weight := 1
+		if (len(code)-2-i)%2 == 0 {
+			weight = 3
+		}
+		sum += weight * int(code[i]-'0')
+	}
+
+	if (10-sum%10)%10 != int(code[len(code)-1]-'0') {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func checkIssn(issn string) error {
+	if len(issn) != 8 {
+		return formatError(ErrorInvalidLength, len(issn))
+	}
+
+	if !isDigits(issn[:7]) {
+		return ErrorInvalidFormat
+	}
+
+	sum := 0
+	for i := 0; i < 7; i++ {
+		sum += (8 - i) * int(issn[i]-'0')
+	}
+
+	check := "0123456789X"[(11-sum%11)%11]
+	if issn[7] != check {
+		if issn[7] != 'X' && !isDigits(issn[7:]) {
+			return ErrorInvalidFormat
+		}
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func checkBic(bic string) error {
+	if len(bic) != 8 && len(bic) != 11 {
+		return formatError(ErrorInvalidLength, len(bic))
+	}
+
+	if matched, _ := regexp.MatchString(constRegexForBic, bic); !matched {
+		return ErrorInvalidFormat
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestChecksums(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(value string) error
+		valid []string
+		wrong []string
+	}{
+		{
+			name:  "luhn",
+			check: checkLuhn,
+			valid: []string{"79927398713", "4111111111111111"},
+			wrong: []string{"79927398710", "4111111111111112", "ABC"},
+		},
+		{
+			name:  "credit-card",
+			check: func(value string) error { return checkCreditCard(value, nil) },
+			valid: []string{"4111111111111111", "5555555555554444", "378282246310005", "6011111111111117", "3530111333300000"},
+			wrong: []string{"4111111111111112", "41111111", "4111 1111 1111 1111"},
+		},
+		{
+			name:  "credit-card=amex",
+			check: func(value string) error { return checkCreditCard(value, []string{"amex"}) },
+			valid: []string{"378282246310005"},
+			wrong: []string{"4111111111111111"},
+		},
+		{
+			name:  "iban",
+			check: checkIban,
+			valid: []string{"GB82WEST12345698765432", "PT50000201231234567890154", "DE89370400440532013000"},
+			wrong: []string{"GB82WEST12345698765431", "PT5000020123123456789015", "XX00", "GB"},
+		},
+		{
+			name:  "isbn",
+			check: checkIsbn,
+			valid: []string{"0306406152", "080442957X", "9780306406157"},
+			wrong: []string{"0306406151", "9780306406158", "123"},
+		},
+		{
+			name:  "isbn10",
+			check: checkIsbn10,
+			valid: []string{"0306406152"},
+			wrong: []string{"9780306406157", "X306406152"},
+		},
+		{
+			name:  "isbn13",
+			check: checkIsbn13,
+			valid: []string{"9780306406157"},
+			wrong: []string{"0306406152", "1230306406157"},
+		},
+		{
+			name:  "ean",
+			check: func(value string) error { return checkGtin(value, 8, 13) },
+			valid: []string{"4006381333931", "96385074"},
+			wrong: []string{"4006381333932", "96385075"},
+		},
+		{
+			name:  "upc",
+			check: func(value string) error { return checkGtin(value, 12) },
+			valid: []string{"036000291452"},
+			wrong: []string{"036000291453", "4006381333931"},
+		},
+		{
+			name:  "issn",
+			check: checkIssn,
+			valid: []string{"03785955", "2434561X"},
+			wrong: []string{"03785956", "0378595"},
+		},
+		{
+			name:  "bic",
+			check: checkBic,
+			valid: []string{"DEUTDEFF", "DEUTDEFF500", "NEDSZAJJXXX"},
+			wrong: []string{"DEUTDEF", "DEUT12FF", "DEUTDEFF5000"},
+		},
+	}
+
+	for _, test := range tests {
+		for _, value := range test.valid {
+			if err := test.check(value); err != nil {
+				t.Errorf("%s: expected [%s] to be valid, got %s", test.name, value, err)
+			}
+		}
+
+		for _, value := range test.wrong {
+			if err := test.check(value); err == nil {
+				t.Errorf("%s: expected [%s] to be invalid", test.name, value)
+			}
+		}
+	}
+}
+
+func TestChecksumTags(t *testing.T) {
+	type example struct {
+		Card string `validate:"credit-card"`
+		Iban string `validate:"iban"`
+		Isbn string `validate:"isbn"`
+		Ean  string `validate:"ean"`
+		Upc  string `validate:"upc"`
+		Issn string `validate:"issn"`
+		Bic  string `validate:"bic"`
+	}
+
+	obj := &example{
+		Card: "4111 1111 1111 1111",
+		Iban: "GB82 WEST 1234 5698 7654 32",
+		Isbn: "978-0-306-40615-7",
+		Ean:  "4006381333931",
+		Upc:  "036000291452",
+		Issn: "0378-5955",
+		Bic:  "deutdeff",
+	}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Errorf("unexpected errors %v", errs)
+	}
+}
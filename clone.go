@@ -0,0 +1,98 @@
+package validator
+
+import "reflect"
+
+func _clone(value reflect.Value) reflect.Value {
+	return _cloneValue(value, make(map[cloneKey]reflect.Value))
+}
+
+// _cloneToPointer returns a pointer to a deep copy of the object, so it can be changed without changing the original
+func _cloneToPointer(obj interface{}) interface{} {
+	value := reflect.ValueOf(obj)
+	if !value.IsValid() {
+		return obj
+	}
+
+	if value.Kind() == reflect.Ptr {
+		return _clone(value).Interface()
+	}
+
+	newValue := reflect.New(value.Type())
+	newValue.Elem().Set(_clone(value))
+
+	return newValue.Interface()
+}
+
+func _cloneValue(value reflect.Value, visited map[cloneKey]reflect.Value) reflect.Value {
+	if !value.IsValid() {
+		return value
+	}
+
+	newValue := reflect.New(value.Type()).Elem()
+
+	switch value.Kind() {
+	case reflect.Ptr:
+		if value.IsNil() {
+			return newValue
+		}
+
+		key := cloneKey{pointer: value.Pointer(), typ: value.Type()}
+		if pointer, ok := visited[key]; ok {
+			newValue.Set(pointer)
+			return newValue
+		}
+
+		pointer := reflect.New(value.Type().Elem())
+		visited[key] = pointer
+		pointer.Elem().Set(_cloneValue(value.Elem(), visited))
+		newValue.Set(pointer)
+
+	case reflect.Interface:
+		if value.IsNil() {
+			return newValue
+		}
+		newValue.Set(_cloneValue(value.Elem(), visited))
+
+	case reflect.Struct:
+		// copies the unexported fields as they are
+		newValue.Set(value)
+
+		for i := 0; i < value.NumField(); i++ {
+			if field := newValue.Field(i); field.CanSet() {
+				field.Set(_cloneValue(value.Field(i), visited))
+			}
+		}
+
+	case reflect.Slice:
+		if value.IsNil() {
+			return newValue
+		}
+
+		newSlice := reflect.MakeSlice(value.Type(), value.Len(), value.Cap())
+		for i := 0; i < value.Len(); i++ {
+			newSlice.Index(i).Set(_cloneValue(value.Index(i), visited))
+		}
+		newValue.Set(newSlice)
+
+	case reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			newValue.Index(i).Set(_cloneValue(value.Index(i), visited))
+		}
+
+	case reflect.Map:
+		if value.IsNil() {
+			return newValue
+		}
+
+		newMap := reflect.MakeMapWithSize(value.Type(), value.Len())
+		for _, key := range value.MapKeys() {
+			newMap.SetMapIndex(_cloneValue(key, visited), _cloneValue(value.MapIndex(key), visited))
+		}
+		newValue.Set(newMap)
+
+	default:
+		newValue.Set(value)
+	}
+
+	return newValue
+}
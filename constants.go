@@ -1,20 +1,29 @@
 package validator
 
+import "time"
+
 // Replace tags
 const (
 	constTagSplitValues    = ";"
+	constTagSplitKeyValue  = ":"
 	constTagReplaceStart   = "{{"
 	constTagReplaceEnd     = "}}"
 	constTagReplaceIdStart = "{"
 	constTagReplaceIdEnd   = "}"
+
+	constTagErrorCodeSeparator = "|"
 )
 
 // Regexes
 const (
-	constRegexForReplaceId = "^" + constTagReplaceIdStart + "[A-Za-z0-9_-]+:?([A-Za-z0-9_-]+;?)+" + constTagReplaceIdEnd + "$"
-	constRegexForReplace   = "^" + constTagReplaceStart + "[A-Za-z0-9_-]+:?([A-Za-z0-9_-]+;?)+" + constTagReplaceEnd + "$"
-	constRegexForEmail     = "^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$"
-	constRegexForTrim      = "  +"
+	constRegexForReplaceId     = "^" + constTagReplaceIdStart + "[A-Za-z0-9_-]+:?([A-Za-z0-9_-]+;?)+" + constTagReplaceIdEnd + "$"
+	constRegexForReplace       = "^" + constTagReplaceStart + "[A-Za-z0-9_-]+:?([A-Za-z0-9_-]+;?)+" + constTagReplaceEnd + "$"
+	constRegexForEmail         = "^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$"
+	constRegexForTrim          = "  +"
+	constRegexForErrorCode     = "^[A-Za-z0-9_.-]+$"
+	constRegexForErrorCodeCall = "^((?:[@;A-Za-z0-9_-]+:)*[A-Za-z0-9_-]+)\\((.*)\\):([A-Za-z0-9_.-]+)$"
+	constRegexForIban          = "^[A-Z]{2}[0-9]{2}[A-Z0-9]+$"
+	constRegexForBic           = "^[A-Z]{4}[A-Z]{2}[A-Z0-9]{2}([A-Z0-9]{3})?$"
 )
 
 // Tags
@@ -25,10 +34,20 @@ const (
 	constTagJson = "json"
 )
 
+// Tags with inline error codes after the separator, the arguments of the other tags can have the separator
+var errorCodeSeparatorTags = map[string]bool{
+	constTagSize: true,
+	constTagMin:  true,
+	constTagMax:  true,
+}
+
 // Validation prefix tags
 const (
 	constPrefixTagItem = "item"
 	constPrefixTagKey  = "key"
+	constPrefixTagWarn = "warn"
+
+	constPrefixTagGroup = "@"
 )
 
 // Validation tags
@@ -67,6 +86,41 @@ const (
 	constTagURL        = "url"
 	constTagHex        = "hex"
 	constTagFile       = "file"
+	constTagSeverity   = "severity"
+	constTagGroups     = "groups"
+	constTagDefault    = "default"
+	constTagLuhn       = "luhn"
+	constTagCreditCard = "credit-card"
+	constTagIban       = "iban"
+	constTagIsbn       = "isbn"
+	constTagIsbn10     = "isbn10"
+	constTagIsbn13     = "isbn13"
+	constTagEan        = "ean"
+	constTagUpc        = "upc"
+	constTagIssn       = "issn"
+	constTagBic        = "bic"
+	constTagTaxId      = "tax-id"
+	constTagPhone      = "phone"
+	constTagIso3166    = "iso3166"
+	constTagIso4217    = "iso4217"
+	constTagBcp47      = "bcp47"
+	constTagPostcode   = "postcode"
+	constTagCidr       = "cidr"
+	constTagCidrV4     = "cidrv4"
+	constTagCidrV6     = "cidrv6"
+	constTagMac        = "mac"
+	constTagHostname   = "hostname"
+	constTagFqdn       = "fqdn"
+	constTagPort       = "port"
+	constTagHostPort   = "hostport"
+	constTagIpIn       = "ip-in"
+	constTagIpPrivate  = "ip-private"
+	constTagIpPublic   = "ip-public"
+	constTagIpLoopback = "ip-loopback"
+	constTagUri        = "uri"
+	constTagUrlHostIn  = "url-host-in"
+	constTagUrlNoCreds = "url-no-credentials"
+	constTagUrlAbs     = "url-absolute"
 )
 
 // Validation set tags
@@ -82,6 +136,63 @@ const (
 	constTagSetSanitize = "set-sanitize"
 	constTagSetMd5      = "set-md5"
 	constTagSetRandom   = "set-random"
+
+	constTagSetSlug             = "set-slug"
+	constTagSetAscii            = "set-ascii"
+	constTagSetCollapseSpaces   = "set-collapse-spaces"
+	constTagSetNormalizeUnicode = "set-normalize-unicode"
+
+	constTagSetSha256   = "set-sha256"
+	constTagSetSha512   = "set-sha512"
+	constTagSetHmac     = "set-hmac"
+	constTagSetBcrypt   = "set-bcrypt"
+	constTagSetArgon2id = "set-argon2id"
+
+	constTagSetPseudonym = "set-pseudonym"
+
+	constTagSetEnv         = "set-env"
+	constTagSetFileContent = "set-file-content"
+
+	constTagSetE164 = "set-e164"
+
+	constTagSetUrlNormalize = "set-url-normalize"
+
+	constTagSetMask      = "set-mask"
+	constTagSetRedact    = "set-redact"
+	constTagSetMaskEmail = "set-mask-email"
+	constTagSetMaskCard  = "set-mask-card"
+)
+
+// Argon2id default values
+const (
+	constArgon2idTime       = 1
+	constArgon2idMemory     = 64 * 1024
+	constArgon2idThreads    = 4
+	constArgon2idSaltLength = 16
+	constArgon2idKeyLength  = 32
+)
+
+// Configuration
+const (
+	constArgumentRequired = "required"
+)
+
+// Masking
+const (
+	constMaskChar      = '*'
+	constMaskCardKeep  = 4
+	constMaskEmailKeep = 1
+	constRedacted      = "[REDACTED]"
+)
+
+// Normalization
+const (
+	constSlugSeparator = "-"
+
+	constNormalizationNFC  = "nfc"
+	constNormalizationNFD  = "nfd"
+	constNormalizationNFKC = "nfkc"
+	constNormalizationNFKD = "nfkd"
 )
 
 // List of values
@@ -90,6 +201,27 @@ const (
 	constAlphanumericUpperAlphabet = "ABCDEFGHUJKLMNOPQRSTUVWXYZÁÉÍÓÚÃÕÂÔÀÈÌÒÙÇ"
 	constNumericAlphabet           = "0123456789"
 	constSpecialAlphabet           = "!\"#$%&/()=?*@€£‰¶÷[]≠§±´`\\|~<>,;.:-_ "
+	constAsciiLowerAlphabet        = "abcdefghijklmnopqrstuvwxyz"
+)
+
+// Random modes
+const (
+	constRandomModeEmail = "email"
+	constRandomModePhone = "phone"
+	constRandomModeUUID  = "uuid"
+)
+
+// Groups
+const (
+	constDefaultGroup = "default"
+)
+
+// Severities
+const (
+	constSeverityError   = "error"
+	constSeverityWarning = "warning"
+	constSeverityWarn    = "warn"
+	constSeverityInfo    = "info"
 )
 
 // Condition tags
@@ -110,7 +242,14 @@ const (
 	constPasswordCheckLower       = "lower"
 	constPasswordCheckSymbol      = "symbol"
 	constPasswordCheckPunctuation = "punctuation"
-	constPasswordBlackListFile    = "./conf/password_black_list.txt"
+	constPasswordCheckLength      = "length"
+	constPasswordCheckBlackList   = "black-list"
+	constPasswordCheckRepeat      = "repeat"
+	constPasswordCheckSequence    = "sequence"
+	constPasswordCheckKeyboard    = "keyboard"
+	constPasswordCheckScore       = "score"
+	constPasswordCheckUserContext = "user-context"
+	constPasswordOptionBreach     = "breach"
 )
 
 // Minimum values
@@ -123,4 +262,198 @@ const (
 	constMinSymbol      = 0
 	constMinPunctuation = 1
 	constMinLength      = 8
+	constMinScore       = 0
+)
+
+// Maximum values
+const (
+	constMaxRepeat   = 0
+	constMaxSequence = 0
+	constMaxKeyboard = 0
+)
+
+// Password policies
+const (
+	constPasswordPolicyDefault = "default"
+	constPasswordPolicyMedium  = "medium"
+	constPasswordPolicyStrong  = "strong"
+
+	constBloomFalsePositiveRate = 0.001
+
+	constBreachPrefixLength   = 5
+	constBreachHashLength     = 40
+	constBreachFileExtension  = ".txt"
+	constBreachCountSeparator = ":"
+	constBreachCacheSize      = 1000
+	constBreachHttpTimeout    = 10 * time.Second
+
+	constPasswordPolicyFormatJson = ".json"
+	constPasswordPolicyFormatYaml = ".yaml"
+	constPasswordPolicyFormatYml  = ".yml"
+)
+
+// Password strength
+const (
+	constPasswordPatternLength     = 3
+	constPasswordUserContextLength = 3
+
+	constPasswordPoolNumeric = 10
+	constPasswordPoolLetter  = 26
+	constPasswordPoolSymbol  = 33
+	constPasswordPoolSpace   = 1
+	constPasswordPoolOther   = 100
+)
+
+var (
+	passwordKeyboardRows   = []string{"1234567890", "qwertyuiop", "asdfghjkl", "zxcvbnm", "azertyuiop", "qsdfghjklm", "wxcvbn", "qwertzuiop", "yxcvbnm"}
+	passwordScoreEntropies = []float64{28, 36, 60, 80}
+)
+
+// Card brands
+const (
+	constCardBrandVisa       = "visa"
+	constCardBrandMastercard = "mastercard"
+	constCardBrandAmex       = "amex"
+	constCardBrandDiscover   = "discover"
+	constCardBrandDiners     = "diners"
+	constCardBrandJcb        = "jcb"
+	constCardBrandUnionPay   = "unionpay"
+	constCardBrandMaestro    = "maestro"
+
+	constCardMinLength = 12
+	constCardMaxLength = 19
+)
+
+// the most specific brands first
+var cardBrands = []*cardBrand{
+	{name: constCardBrandAmex, prefixes: [][2]int{{34, 34}, {37, 37}}, lengths: []int{15}},
+	{name: constCardBrandDiners, prefixes: [][2]int{{300, 305}, {36, 36}, {38, 39}}, lengths: []int{14, 15, 16, 17, 18, 19}},
+	{name: constCardBrandJcb, prefixes: [][2]int{{3528, 3589}}, lengths: []int{16, 17, 18, 19}},
+	{name: constCardBrandVisa, prefixes: [][2]int{{4, 4}}, lengths: []int{13, 16, 19}},
+	{name: constCardBrandMastercard, prefixes: [][2]int{{51, 55}, {2221, 2720}}, lengths: []int{16}},
+	{name: constCardBrandDiscover, prefixes: [][2]int{{6011, 6011}, {644, 649}, {65, 65}}, lengths: []int{16, 17, 18, 19}},
+	{name: constCardBrandUnionPay, prefixes: [][2]int{{62, 62}}, lengths: []int{16, 17, 18, 19}},
+	{name: constCardBrandMaestro, prefixes: [][2]int{{50, 50}, {56, 69}}, lengths: []int{12, 13, 14, 15, 16, 17, 18, 19}},
+}
+
+// Iban lengths by country
+var ibanLengths = map[string]int{
+	"AD": 24, "AE": 23, "AL": 28, "AT": 20, "AZ": 28, "BA": 20, "BE": 16, "BG": 22, "BH": 22, "BI": 27,
+	"BR": 29, "BY": 28, "CH": 21, "CR": 22, "CY": 28, "CZ": 24, "DE": 22, "DJ": 27, "DK": 18, "DO": 28,
+	"EE": 20, "EG": 29, "ES": 24, "FI": 18, "FK": 18, "FO": 18, "FR": 27, "GB": 22, "GE": 22, "GI": 23,
+	"GL": 18, "GR": 27, "GT": 28, "HR": 21, "HU": 28, "IE": 22, "IL": 23, "IQ": 23, "IS": 26, "IT": 27,
+	"JO": 30, "KW": 30, "KZ": 20, "LB": 28, "LC": 32, "LI": 21, "LT": 20, "LU": 20, "LV": 21, "LY": 25,
+	"MC": 27, "MD": 24, "ME": 22, "MK": 19, "MN": 20, "MR": 27, "MT": 31, "MU": 30, "NI": 28, "NL": 18,
+	"NO": 15, "OM": 23, "PK": 24, "PL": 28, "PS": 29, "PT": 25, "QA": 29, "RO": 24, "RS": 22, "RU": 33,
+	"SA": 24, "SC": 31, "SD": 18, "SE": 24, "SI": 19, "SK": 24, "SM": 27, "SO": 23, "ST": 25, "SV": 28,
+	"TL": 23, "TN": 24, "TR": 26, "UA": 29, "VA": 22, "VG": 24, "XK": 20,
+}
+
+// Tax ids
+const (
+	constTaxIdPt     = "pt"
+	constTaxIdPtNif  = "pt-nif"
+	constTaxIdPtNiss = "pt-niss"
+	constTaxIdPtCc   = "pt-cc"
+	constTaxIdEs     = "es"
+	constTaxIdEsDni  = "es-dni"
+	constTaxIdEsNie  = "es-nie"
+	constTaxIdEsCif  = "es-cif"
+	constTaxIdBr     = "br"
+	constTaxIdBrCpf  = "br-cpf"
+	constTaxIdBrCnpj = "br-cnpj"
+	constTaxIdEuVat  = "eu-vat"
+
+	constTaxIdDniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+	constTaxIdCifLetters = "JABCDEFGHI"
+)
+
+// Vat number formats by country, without the country code
+var vatFormats = map[string]string{
+	"AT": "^U[0-9]{8}$",
+	"BE": "^[01][0-9]{9}$",
+	"BG": "^[0-9]{9,10}$",
+	"CY": "^[0-9]{8}[A-Z]$",
+	"CZ": "^[0-9]{8,10}$",
+	"DE": "^[0-9]{9}$",
+	"DK": "^[0-9]{8}$",
+	"EE": "^[0-9]{9}$",
+	"EL": "^[0-9]{9}$",
+	"ES": "^[A-Z0-9][0-9]{7}[A-Z0-9]$",
+	"FI": "^[0-9]{8}$",
+	"FR": "^[A-HJ-NP-Z0-9]{2}[0-9]{9}$",
+	"HR": "^[0-9]{11}$",
+	"HU": "^[0-9]{8}$",
+	"IE": "^([0-9]{7}[A-W][A-I]?|[0-9][A-Z+*][0-9]{5}[A-W])$",
+	"IT": "^[0-9]{11}$",
+	"LT": "^([0-9]{9}|[0-9]{12})$",
+	"LU": "^[0-9]{8}$",
+	"LV": "^[0-9]{11}$",
+	"MT": "^[0-9]{8}$",
+	"NL": "^[0-9]{9}B[0-9]{2}$",
+	"PL": "^[0-9]{10}$",
+	"PT": "^[0-9]{9}$",
+	"RO": "^[0-9]{2,10}$",
+	"SE": "^[0-9]{12}$",
+	"SI": "^[0-9]{8}$",
+	"SK": "^[0-9]{10}$",
+	"XI": "^([0-9]{9}|[0-9]{12}|GD[0-9]{3}|HA[0-9]{3})$",
+}
+
+// Phones
+const (
+	constPhoneTypeMobile        = "mobile"
+	constPhoneTypeFixed         = "fixed"
+	constPhoneTypeFixedOrMobile = "fixed-or-mobile"
+	constPhoneTypeUnknown       = "unknown"
+
+	constPhoneInternationalPrefix = "+"
+	constPhoneExitCode            = "00"
+	constPhoneMaxCallingCode      = 3
+	constPhoneAreaCodeLength      = 3
+	constPhoneE164MinLength       = 7
+	constPhoneE164MaxLength       = 15
+)
+
+// Iso codes
+const (
+	constIsoAlpha2  = "alpha2"
+	constIsoAlpha3  = "alpha3"
+	constIsoNumeric = "numeric"
+	constIsoCode    = "code"
+)
+
+// Networks
+const (
+	constHostnameMaxLength      = 253
+	constHostnameMaxLabelLength = 63
+
+	constPortMin = 1
+	constPortMax = 65535
+
+	constIpTypePrivate  = "private"
+	constIpTypePublic   = "public"
+	constIpTypeLoopback = "loopback"
+)
+
+// Urls
+const (
+	constUrlInvalidChars = " \t\r\n"
+	constUriAllowedChars = "-._~:/?#[]@!$&'()*+,;="
+	constUrlWildcard     = "*."
+)
+
+// Default ports by scheme, removed on the normalization
+var urlDefaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+	"ws":    "80",
+	"wss":   "443",
+	"ftp":   "21",
+}
+
+// Metadata
+const (
+	constMetadataPhone     = "phone"
+	constMetadataPhoneType = "phone_type"
 )
@@ -0,0 +1,146 @@
+package validator
+
+import (
+	"encoding"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joaosoft/errors"
+)
+
+var (
+	typeDuration        = reflect.TypeOf(time.Duration(0))
+	typeTextUnmarshaler = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+// _convertValue converts the text to a value of the type, allocating the pointers
+func _convertValue(typ reflect.Type, str string) (reflect.Value, error) {
+	if reflect.PtrTo(typ).Implements(typeTextUnmarshaler) {
+		newValue := reflect.New(typ)
+		if err := newValue.Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(str)); err != nil {
+			return reflect.Value{}, err
+		}
+
+		return newValue.Elem(), nil
+	}
+
+	if typ == typeDuration {
+		duration, err := time.ParseDuration(str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		return reflect.ValueOf(duration), nil
+	}
+
+	newValue := reflect.New(typ).Elem()
+
+	switch typ.Kind() {
+	case reflect.Ptr:
+		elem, err := _convertValue(typ.Elem(), str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+
+		newValue = reflect.New(typ.Elem())
+		newValue.Elem().Set(elem)
+
+	case reflect.String:
+		newValue.SetString(str)
+
+	case reflect.Bool:
+		value, err := strconv.ParseBool(str)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newValue.SetBool(value)
+
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(str, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newValue.SetInt(value)
+
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(str, 10, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newValue.SetUint(value)
+
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(str, typ.Bits())
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		newValue.SetFloat(value)
+
+	case reflect.Slice:
+		// values: a;b;c
+		newValue = reflect.MakeSlice(typ, 0, 0)
+		if str == "" {
+			return newValue, nil
+		}
+
+		for _, item := range strings.Split(str, constTagSplitValues) {
+			elem, err := _convertValue(typ.Elem(), strings.TrimSpace(item))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+			newValue = reflect.Append(newValue, elem)
+		}
+
+	case reflect.Map:
+		// values: key:value;key:value
+		newValue = reflect.MakeMap(typ)
+		if str == "" {
+			return newValue, nil
+		}
+
+		for _, item := range strings.Split(str, constTagSplitValues) {
+			split := strings.SplitN(item, constTagSplitKeyValue, 2)
+			if len(split) != 2 {
+				return reflect.Value{}, formatError(ErrorInvalidTagArgument, item)
+			}
+
+			key, err := _convertValue(typ.Key(), strings.TrimSpace(split[0]))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			elem, err := _convertValue(typ.Elem(), strings.TrimSpace(split[1]))
+			if err != nil {
+				return reflect.Value{}, err
+			}
+
+			newValue.SetMapIndex(key, elem)
+		}
+
+	case reflect.Interface:
+		if !reflect.TypeOf(str).AssignableTo(typ) {
+			return reflect.Value{}, formatError(ErrorUnsupportedType, typ)
+		}
+		newValue.Set(reflect.ValueOf(str))
+
+	default:
+		return reflect.Value{}, formatError(ErrorUnsupportedType, typ)
+	}
+
+	return newValue, nil
+}
+
+// _setConvertedValue sets the converted text, reporting the conversion errors with the field and the rule
+func (v *Validator) _setConvertedValue(validationData *ValidationData, obj reflect.Value, str string, errInvalid *errors.Error) error {
+	newValue, err := _convertValue(obj.Type(), str)
+	if err != nil {
+		rule := &errorRule{tag: validationData.Tag, expected: validationData.Expected}
+		return v.newValidationError(validationData, rule, "", formatError(errInvalid, str, obj.Type()), err)
+	}
+
+	obj.Set(newValue)
+
+	return nil
+}
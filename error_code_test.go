@@ -0,0 +1,106 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestGetErrorCode(t *testing.T) {
+	tests := []struct {
+		validation string
+		expected   string
+		code       string
+	}{
+		{"email|E_EMAIL", "email", "E_EMAIL"},
+		{"min=5|E_MIN_5", "min=5", "E_MIN_5"},
+		{"item:max=3|E_MAX", "item:max=3", "E_MAX"},
+		{"min(5):E_MIN", "min=5", "E_MIN"},
+		{"@create:min(5):E_MIN", "@create:min=5", "E_MIN"},
+		{"email():E_EMAIL", "email", "E_EMAIL"},
+		{"options(a|B):E_OPTIONS", "options=a|B", "E_OPTIONS"},
+		{"set=a|b", "set=a|b", ""},
+		{"options=a|B", "options=a|B", ""},
+		{"value=x|y", "value=x|y", ""},
+		{"prefix=a|b", "prefix=a|b", ""},
+		{"contains=a|b", "contains=a|b", ""},
+		{"regex=^(a|b)$", "regex=^(a|b)$", ""},
+		{"min=5", "min=5", ""},
+	}
+
+	vc := NewValidatorHandler(NewValidator())
+	for _, test := range tests {
+		validation, code := vc.getErrorCode(test.validation)
+		if validation != test.expected || code != test.code {
+			t.Errorf("%q: expected (%q, %q), got (%q, %q)", test.validation, test.expected, test.code, validation, code)
+		}
+	}
+}
+
+func TestInlineErrorCodeWithSeparatorOnArguments(t *testing.T) {
+	type example struct {
+		Set     string `validate:"set=a|b"`
+		Options string `validate:"options=a|B"`
+		Min     string `validate:"min(5):E_MIN"`
+		Max     string `validate:"max=2|E_MAX"`
+	}
+
+	obj := &example{Options: "a|B", Min: "abcdef", Max: "ab"}
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if obj.Set != "a|b" {
+		t.Errorf("expected the value [a|b], got [%s]", obj.Set)
+	}
+
+	obj = &example{Options: "a", Min: "abc", Max: "abc"}
+	errs := NewValidator().SetValidateAll(true).Validate(obj)
+
+	codes := make(map[string]bool)
+	for _, err := range errs {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			codes[validationErr.Code] = true
+		}
+	}
+
+	for _, code := range []string{"E_MIN", "E_MAX"} {
+		if !codes[code] {
+			t.Errorf("expected the code [%s] on the errors %v", code, errs)
+		}
+	}
+}
+
+func TestErrorCodes(t *testing.T) {
+	type example struct {
+		Max   string `validate:"max=2|E_MAX"`
+		Email string `validate:"email"`
+		Size  string `validate:"size=2"`
+	}
+
+	errs := NewValidator().
+		SetValidateAll(true).
+		SetDefaultErrorCode("email", "E_EMAIL").
+		Validate(&example{Max: "abc", Email: "invalid", Size: "abc"})
+
+	codes := make(map[string]string)
+	for _, err := range errs {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			codes[validationErr.Field] = validationErr.Code
+		} else {
+			codes[""] = err.Error()
+		}
+	}
+
+	expected := map[string]string{"Max": "E_MAX", "Email": "E_EMAIL", "": ErrorInvalidValue.Error()}
+	if len(codes) != len(expected) {
+		t.Fatalf("expected the codes %v, got %v", expected, codes)
+	}
+
+	for field, code := range expected {
+		if codes[field] != code {
+			t.Errorf("%s: expected the code [%s], got [%s]", field, code, codes[field])
+		}
+	}
+}
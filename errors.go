@@ -1,6 +1,10 @@
 package validator
 
-import "github.com/joaosoft/errors"
+import (
+	"fmt"
+
+	"github.com/joaosoft/errors"
+)
 
 var (
 	ErrorSkipValidation     = errors.New(errors.LevelError, 1, "skip validation")
@@ -9,4 +13,68 @@ var (
 	ErrorInvalidTag         = errors.New(errors.LevelError, 4, "invalid tag [%s]")
 	ErrorInvalidTagArgument = errors.New(errors.LevelError, 5, "invalid tag argument [%s]")
 	ErrorInvalidTagPrefix   = errors.New(errors.LevelError, 6, "invalid prefix [%s] on tag [%s]")
+	ErrorInvalidDefault     = errors.New(errors.LevelError, 7, "invalid default value [%s] for type [%s]")
+	ErrorUnsupportedType    = errors.New(errors.LevelError, 8, "unsupported type [%s]")
+	ErrorInvalidTypeValue   = errors.New(errors.LevelError, 9, "invalid value [%s] for type [%s]")
+	ErrorMissingEnv         = errors.New(errors.LevelError, 10, "missing required environment variable [%s]")
+	ErrorMissingFile        = errors.New(errors.LevelError, 11, "missing required file [%s]")
+
+	ErrorPasswordLength      = errors.New(errors.LevelError, 12, "password must have at least [%d] characters")
+	ErrorPasswordNumeric     = errors.New(errors.LevelError, 13, "password must have at least [%d] numeric characters")
+	ErrorPasswordLetter      = errors.New(errors.LevelError, 14, "password must have at least [%d] letters")
+	ErrorPasswordUpper       = errors.New(errors.LevelError, 15, "password must have at least [%d] upper case letters")
+	ErrorPasswordLower       = errors.New(errors.LevelError, 16, "password must have at least [%d] lower case letters")
+	ErrorPasswordSpace       = errors.New(errors.LevelError, 17, "password must have at least [%d] spaces")
+	ErrorPasswordSymbol      = errors.New(errors.LevelError, 18, "password must have at least [%d] symbols")
+	ErrorPasswordPunctuation = errors.New(errors.LevelError, 19, "password must have at least [%d] punctuation characters")
+	ErrorPasswordBlackList   = errors.New(errors.LevelError, 20, "password is too common")
+	ErrorPasswordRepeat      = errors.New(errors.LevelError, 21, "password can not have more than [%d] repeated characters")
+	ErrorPasswordSequence    = errors.New(errors.LevelError, 22, "password can not have more than [%d] sequential characters")
+	ErrorPasswordKeyboard    = errors.New(errors.LevelError, 23, "password can not have more than [%d] characters of a keyboard pattern")
+	ErrorPasswordScore       = errors.New(errors.LevelError, 24, "password strength [%d] is lower than [%d]")
+	ErrorInvalidFileFormat   = errors.New(errors.LevelError, 25, "invalid file format [%s]")
+	ErrorPasswordUserContext = errors.New(errors.LevelError, 26, "password can not contain personal information")
+	ErrorPasswordBreached    = errors.New(errors.LevelError, 27, "password was found [%d] times on data breaches")
+	ErrorBreachProvider      = errors.New(errors.LevelError, 28, "password breach provider is not defined")
+	ErrorBreachResponse      = errors.New(errors.LevelError, 29, "invalid password breach response with status [%d]")
+
+	ErrorInvalidFormat    = errors.New(errors.LevelError, 30, "invalid format")
+	ErrorInvalidLength    = errors.New(errors.LevelError, 31, "invalid length [%d]")
+	ErrorInvalidChecksum  = errors.New(errors.LevelError, 32, "invalid checksum")
+	ErrorInvalidCountry   = errors.New(errors.LevelError, 33, "invalid country [%s]")
+	ErrorInvalidCardBrand = errors.New(errors.LevelError, 34, "card brand [%s] is not allowed")
+	ErrorInvalidPhone     = errors.New(errors.LevelError, 35, "invalid phone number for the country [%s]")
+	ErrorInvalidCurrency  = errors.New(errors.LevelError, 36, "invalid currency [%s]")
+	ErrorInvalidLanguage  = errors.New(errors.LevelError, 37, "invalid language tag [%s]")
+	ErrorInvalidPostcode  = errors.New(errors.LevelError, 38, "invalid postcode for the country [%s]")
+	ErrorInvalidHostname  = errors.New(errors.LevelError, 39, "invalid hostname [%s]")
+	ErrorInvalidPort      = errors.New(errors.LevelError, 40, "invalid port [%s]")
+	ErrorInvalidIpRange   = errors.New(errors.LevelError, 41, "ip [%s] is not on the networks [%s]")
+	ErrorInvalidIpType    = errors.New(errors.LevelError, 42, "ip [%s] is not %s")
+	ErrorInvalidScheme    = errors.New(errors.LevelError, 43, "scheme [%s] is not allowed")
+	ErrorInvalidHost      = errors.New(errors.LevelError, 44, "host [%s] is not allowed")
+	ErrorUrlCredentials   = errors.New(errors.LevelError, 45, "url can not have credentials")
+	ErrorUrlNotAbsolute   = errors.New(errors.LevelError, 46, "url must be absolute")
+	ErrorDuplicatedKey    = errors.New(errors.LevelError, 47, "the changed map key [%v] already exists")
 )
+
+func (e *ValidationError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() []error {
+	if e.Original == nil {
+		return []error{e.Err}
+	}
+
+	return []error{e.Err, e.Original}
+}
+
+// formatError returns a copy of the error with the formatted message, so the shared error is not changed
+func formatError(err *errors.Error, values ...interface{}) *errors.Error {
+	return &errors.Error{
+		Level:   err.Level,
+		Code:    err.Code,
+		Message: fmt.Sprintf(err.Message, values...),
+	}
+}
@@ -127,6 +127,28 @@ type Example2 struct {
 	UUIDStruct      uuid.UUID `validate:"uuid"`
 }
 
+type Example5 struct {
+	Country    string            `validate:"id=country, iso3166=alpha2"`
+	Currency   string            `validate:"iso4217"`
+	Language   string            `validate:"bcp47"`
+	Postcode   string            `validate:"postcode={country}"`
+	Phone      string            `validate:"phone={country}, set-e164={country}"`
+	PhoneUS    string            `validate:"phone, set-e164"`
+	TaxId      string            `validate:"tax-id=pt"`
+	Vat        string            `validate:"tax-id=eu-vat"`
+	CreditCard string            `validate:"credit-card=visa;mastercard"`
+	Iban       string            `validate:"iban"`
+	Isbn       string            `validate:"isbn"`
+	Cidr       string            `validate:"cidr"`
+	Ip         string            `validate:"ip-private"`
+	HostPort   string            `validate:"hostport"`
+	Url        string            `validate:"url=https, url-host-in=*.example.com, set-url-normalize"`
+	MinCode    string            `validate:"min(5):ErrorTag20"`
+	SizeCode   string            `validate:"size=3|ErrorTag21"`
+	Options    string            `validate:"options(a|b):ErrorTag21"`
+	Items      map[string]string `validate:"item:set-trim, key:set-upper"`
+}
+
 type Example3 struct {
 	Name     string `validate:"value=joao"`
 	LastName string `validate:"set=ribeiro"`
@@ -393,6 +415,41 @@ func main() {
 		}
 	}
 
+	// validate the identifiers, the networks, the inline error codes and the map items
+	example5 := Example5{
+		Country:    "PT",
+		Currency:   "EUR",
+		Language:   "pt-PT",
+		Postcode:   "1000-001",
+		Phone:      "912 345 678",
+		PhoneUS:    "+1 (212) 555-0100",
+		TaxId:      "501964843",
+		Vat:        "PT501964844",
+		CreditCard: "4111 1111 1111 1111",
+		Iban:       "PT50000201231234567890154",
+		Isbn:       "978-0-306-40615-7",
+		Cidr:       "10.0.0.0/8",
+		Ip:         "8.8.8.8",
+		HostPort:   "example.com:80",
+		Url:        "HTTPS://API.Example.COM:443/?b=2&a=1",
+		MinCode:    "abc",
+		SizeCode:   "abcd",
+		Options:    "c",
+		Items:      map[string]string{"a": "  one  ", "b": "two"},
+	}
+
+	if errs := validator.Validate(&example5); len(errs) > 0 {
+		fmt.Printf("\n\nERRORS: %d\n", len(errs))
+		for _, err := range errs {
+			fmt.Printf("\nERROR: %s", err)
+		}
+	}
+
+	fmt.Printf("\n\nAFTER PHONE: %s", example5.Phone)
+	fmt.Printf("\nAFTER PHONE US: %s", example5.PhoneUS)
+	fmt.Printf("\nAFTER URL: %s", example5.Url)
+	fmt.Printf("\nAFTER ITEMS: %+v", example5.Items)
+
 	// benchmark
 	timingValidator()
 	timingManualValidation()
@@ -0,0 +1,68 @@
+package validator
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+var indexreg = regexp.MustCompile(`\[[^\]]*\]`)
+
+func newFieldSet(fields []string) map[string]empty {
+	set := make(map[string]empty)
+
+	for _, field := range fields {
+		set[strings.TrimSpace(field)] = empty{}
+	}
+
+	return set
+}
+
+func (p fieldPath) field(typ reflect.StructField) fieldPath {
+	jsonName := typ.Name
+	if tag, exists := typ.Tag.Lookup(constTagJson); exists {
+		if split := strings.SplitN(tag, ",", 2); split[0] != "" && split[0] != "-" {
+			jsonName = split[0]
+		}
+	}
+
+	return fieldPath{
+		name: joinPath(p.name, typ.Name),
+		json: joinPath(p.json, jsonName),
+	}
+}
+
+func (p fieldPath) index(key interface{}) fieldPath {
+	return fieldPath{
+		name: fmt.Sprintf("%s[%v]", p.name, key),
+		json: fmt.Sprintf("%s[%v]", p.json, key),
+	}
+}
+
+// in checks if the path, or one of its parents, is on the set (by go or json name, with or without indexes)
+func (p fieldPath) in(set map[string]empty) bool {
+	for _, path := range []string{p.name, p.json, indexreg.ReplaceAllString(p.name, ""), indexreg.ReplaceAllString(p.json, "")} {
+		for {
+			if _, ok := set[path]; ok {
+				return true
+			}
+
+			index := strings.LastIndex(path, ".")
+			if index == -1 {
+				break
+			}
+			path = path[:index]
+		}
+	}
+
+	return false
+}
+
+func joinPath(parent string, name string) string {
+	if parent == "" {
+		return name
+	}
+
+	return parent + "." + name
+}
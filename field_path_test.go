@@ -0,0 +1,101 @@
+package validator
+
+import (
+	"testing"
+)
+
+type fieldPathAddress struct {
+	Street string `json:"street" validate:"not-empty"`
+	Zip    string `json:"zip" validate:"size=4"`
+}
+
+type fieldPathExample struct {
+	Name    string             `json:"name" validate:"not-empty"`
+	Age     int                `json:"age" validate:"min=18"`
+	Address fieldPathAddress   `json:"address"`
+	Items   []fieldPathAddress `json:"items"`
+}
+
+func newFieldPathExample() *fieldPathExample {
+	return &fieldPathExample{
+		Age:     10,
+		Address: fieldPathAddress{Zip: "123"},
+		Items:   []fieldPathAddress{{Zip: "12345"}},
+	}
+}
+
+func TestFieldPathIn(t *testing.T) {
+	path := fieldPath{name: "Items[0].Zip", json: "items[0].zip"}
+
+	tests := []struct {
+		fields   []string
+		expected bool
+	}{
+		{[]string{"Items[0].Zip"}, true},
+		{[]string{"items[0].zip"}, true},
+		{[]string{"items.zip"}, true},
+		{[]string{"Items"}, true},
+		{[]string{"items[0]"}, true},
+		{[]string{"items[1].zip"}, false},
+		{[]string{"items.street"}, false},
+		{[]string{"zip"}, false},
+	}
+
+	for _, test := range tests {
+		if in := path.in(newFieldSet(test.fields)); in != test.expected {
+			t.Errorf("%v: expected %t, got %t", test.fields, test.expected, in)
+		}
+	}
+}
+
+func TestValidatePartial(t *testing.T) {
+	tests := []struct {
+		fields []string
+		errors int
+	}{
+		{[]string{"name"}, 1},
+		{[]string{"Name"}, 1},
+		{[]string{"age", "address.street"}, 2},
+		{[]string{"address"}, 2},
+		{[]string{"Address.Zip"}, 1},
+		{[]string{"items"}, 2},
+		{[]string{"items[0].zip"}, 1},
+		{[]string{"Items.Street"}, 1},
+		{[]string{"unknown"}, 0},
+	}
+
+	validator := NewValidator().SetValidateAll(true)
+	for _, test := range tests {
+		if errs := validator.ValidatePartial(newFieldPathExample(), test.fields...); len(errs) != test.errors {
+			t.Errorf("%v: expected %d errors, got %v", test.fields, test.errors, errs)
+		}
+	}
+}
+
+func TestValidateExcept(t *testing.T) {
+	tests := []struct {
+		fields []string
+		errors int
+	}{
+		{nil, 6},
+		{[]string{"name"}, 5},
+		{[]string{"address", "items"}, 2},
+		{[]string{"items[0].zip"}, 5},
+		{[]string{"Items.Zip", "address.zip"}, 4},
+	}
+
+	validator := NewValidator().SetValidateAll(true)
+	for _, test := range tests {
+		if errs := validator.ValidateExcept(newFieldPathExample(), test.fields...); len(errs) != test.errors {
+			t.Errorf("%v: expected %d errors, got %v", test.fields, test.errors, errs)
+		}
+	}
+}
+
+func TestWithNonZero(t *testing.T) {
+	// the empty name and the empty streets are not validated
+	errs := NewValidator().SetValidateAll(true).Validate(newFieldPathExample(), WithNonZero())
+	if len(errs) != 3 {
+		t.Errorf("expected 3 errors, got %v", errs)
+	}
+}
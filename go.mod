@@ -6,9 +6,13 @@ require (
 	github.com/joaosoft/errors v0.0.0-20230531141818-ebb38600b462
 	github.com/joaosoft/logger v0.0.0-20240321164508-fe379344de3b
 	github.com/satori/go.uuid v1.2.0
+	golang.org/x/crypto v0.17.0
+	golang.org/x/text v0.14.0
+	gopkg.in/yaml.v3 v3.0.1
 )
 
 require (
 	github.com/joaosoft/writers v0.0.0-20230531142123-83465954fcda // indirect
 	github.com/kr/text v0.2.0 // indirect
+	golang.org/x/sys v0.15.0 // indirect
 )
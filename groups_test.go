@@ -0,0 +1,72 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestGroups(t *testing.T) {
+	type example struct {
+		Id    string `validate:"@create:is-empty, @update:not-empty"`
+		Name  string `validate:"@create;update:max=3"`
+		Email string `validate:"groups=update, not-empty"`
+		Age   int    `validate:"max=10"`
+	}
+
+	tests := []struct {
+		obj    example
+		groups []string
+		errors int
+	}{
+		{example{Id: ""}, []string{"create"}, 0},
+		{example{Id: "1"}, []string{"create"}, 1},
+		{example{Id: ""}, []string{"update"}, 2},
+		{example{Id: "1", Name: "abcd", Email: "a"}, []string{"update"}, 1},
+		{example{Id: "1", Name: "abcd", Age: 11}, nil, 1},
+		{example{Id: "1", Name: "abcd", Age: 11}, []string{"create", "default"}, 3},
+	}
+
+	for i, test := range tests {
+		var options []Option
+		if test.groups != nil {
+			options = append(options, WithGroups(test.groups...))
+		}
+
+		if errs := NewValidator().SetValidateAll(true).Validate(&test.obj, options...); len(errs) != test.errors {
+			t.Errorf("test %d: expected %d errors, got %v", i, test.errors, errs)
+		}
+	}
+}
+
+func TestGroupSequence(t *testing.T) {
+	type example struct {
+		Name string `validate:"not-empty"`
+		Id   string `validate:"@update:not-empty"`
+	}
+
+	tests := []struct {
+		obj    example
+		errors int
+	}{
+		{example{Name: "", Id: ""}, 1},
+		{example{Name: "a", Id: ""}, 1},
+		{example{Name: "a", Id: "1"}, 0},
+	}
+
+	for i, test := range tests {
+		errs := NewValidator().SetValidateAll(true).Validate(&test.obj, WithGroupSequence("default", "update"))
+		if len(errs) != test.errors {
+			t.Errorf("test %d: expected %d errors, got %v", i, test.errors, errs)
+		}
+	}
+}
+
+func TestUnknownPrefix(t *testing.T) {
+	type example struct {
+		Items []string `validate:"itme:size=3"`
+	}
+
+	errs := NewValidator().Validate(&example{Items: []string{"a"}})
+	if len(errs) != 1 || !isErrorCode(errs[0], ErrorInvalidTagPrefix) {
+		t.Errorf("expected the error [%s], got %v", ErrorInvalidTagPrefix, errs)
+	}
+}
@@ -2,8 +2,10 @@ package validator
 
 func (v *Validator) newDefaultBeforeHandlers() map[string]beforeTagHandler {
 	return map[string]beforeTagHandler{
-		constTagId:   v.validate_id,
-		constTagIf:   v.validate_if,
-		constTagArgs: v.validate_args,
+		constTagId:       v.validate_id,
+		constTagIf:       v.validate_if,
+		constTagArgs:     v.validate_args,
+		constTagSeverity: v.validate_severity,
+		constTagGroups:   v.validate_groups,
 	}
 }
@@ -0,0 +1,10 @@
+package validator
+
+func (v *Validator) newDefaultMaskHandlers() map[string]mutationTagHandler {
+	return map[string]mutationTagHandler{
+		constTagSetMask:      v.validate_set_mask,
+		constTagSetRedact:    v.validate_set_redact,
+		constTagSetMaskEmail: v.validate_set_mask_email,
+		constTagSetMaskCard:  v.validate_set_mask_card,
+	}
+}
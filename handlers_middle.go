@@ -32,16 +32,37 @@ func (v *Validator) newDefaultMiddleHandlers() map[string]middleTagHandler {
 		constTagHex:        v.validate_hex,
 		constTagFile:       v.validate_file,
 
-		constTagSet:         v.validate_set,
-		constTagSetEmpty:    v.validate_set_empty,
-		constTagSetDistinct: v.validate_set_distinct,
-		constTagSetTrim:     v.validate_set_trim,
-		constTagSetTitle:    v.validate_set_title,
-		constTagSetLower:    v.validate_set_lower,
-		constTagSetUpper:    v.validate_set_upper,
-		constTagSetKey:      v.validate_set_key,
-		constTagSetSanitize: v.validate_set_sanitize,
-		constTagSetMd5:      v.validate_set_md5,
-		constTagSetRandom:   v.validate_set_random,
+		constTagLuhn:       v.validate_luhn,
+		constTagCreditCard: v.validate_credit_card,
+		constTagIban:       v.validate_iban,
+		constTagIsbn:       v.validate_isbn,
+		constTagIsbn10:     v.validate_isbn10,
+		constTagIsbn13:     v.validate_isbn13,
+		constTagEan:        v.validate_ean,
+		constTagUpc:        v.validate_upc,
+		constTagIssn:       v.validate_issn,
+		constTagBic:        v.validate_bic,
+		constTagTaxId:      v.validate_tax_id,
+		constTagPhone:      v.validate_phone,
+		constTagIso3166:    v.validate_iso3166,
+		constTagIso4217:    v.validate_iso4217,
+		constTagBcp47:      v.validate_bcp47,
+		constTagPostcode:   v.validate_postcode,
+		constTagCidr:       v.validate_cidr,
+		constTagCidrV4:     v.validate_cidrv4,
+		constTagCidrV6:     v.validate_cidrv6,
+		constTagMac:        v.validate_mac,
+		constTagHostname:   v.validate_hostname,
+		constTagFqdn:       v.validate_fqdn,
+		constTagPort:       v.validate_port,
+		constTagHostPort:   v.validate_hostport,
+		constTagIpIn:       v.validate_ip_in,
+		constTagIpPrivate:  v.validate_ip_private,
+		constTagIpPublic:   v.validate_ip_public,
+		constTagIpLoopback: v.validate_ip_loopback,
+		constTagUri:        v.validate_uri,
+		constTagUrlHostIn:  v.validate_url_host_in,
+		constTagUrlNoCreds: v.validate_url_no_credentials,
+		constTagUrlAbs:     v.validate_url_absolute,
 	}
 }
@@ -0,0 +1,53 @@
+package validator
+
+func (v *Validator) newDefaultMutationHandlers() map[string]mutationTagHandler {
+	return map[string]mutationTagHandler{
+		constTagSet:         v.validate_set,
+		constTagSetEmpty:    v.validate_set_empty,
+		constTagSetDistinct: v.validate_set_distinct,
+		constTagSetTrim:     v.validate_set_trim,
+		constTagSetTitle:    v.validate_set_title,
+		constTagSetLower:    v.validate_set_lower,
+		constTagSetUpper:    v.validate_set_upper,
+		constTagSetKey:      v.validate_set_key,
+		constTagSetSanitize: v.validate_set_sanitize,
+		constTagSetMd5:      v.validate_set_md5,
+		constTagSetRandom:   v.validate_set_random,
+
+		constTagDefault: v.validate_default,
+
+		constTagSetEnv:         v.validate_set_env,
+		constTagSetFileContent: v.validate_set_file_content,
+
+		constTagSetE164: v.validate_set_e164,
+
+		constTagSetUrlNormalize: v.validate_set_url_normalize,
+
+		constTagSetSlug:             v.validate_set_slug,
+		constTagSetAscii:            v.validate_set_ascii,
+		constTagSetCollapseSpaces:   v.validate_set_collapse_spaces,
+		constTagSetNormalizeUnicode: v.validate_set_normalize_unicode,
+
+		constTagSetSha256:   v.newHashHandler(&sha256Hasher{}),
+		constTagSetSha512:   v.newHashHandler(&sha512Hasher{}),
+		constTagSetHmac:     v.newHashHandler(&hmacHasher{keys: v.hmacKeys}),
+		constTagSetBcrypt:   v.newHashHandler(&bcryptHasher{}),
+		constTagSetArgon2id: v.newHashHandler(&argon2idHasher{}),
+
+		constTagSetPseudonym: v.validate_set_pseudonym,
+	}
+}
+
+// the tags whose old value is not reported on the changes, like the hashes
+func (v *Validator) newDefaultSensitiveTags() map[string]empty {
+	return map[string]empty{
+		constTagSetMd5:       {},
+		constTagSetRandom:    {},
+		constTagSetSha256:    {},
+		constTagSetSha512:    {},
+		constTagSetHmac:      {},
+		constTagSetBcrypt:    {},
+		constTagSetArgon2id:  {},
+		constTagSetPseudonym: {},
+	}
+}
@@ -0,0 +1,18 @@
+package validator
+
+func (v *Validator) newDefaultTaxIdHandlers() map[string]taxIdHandler {
+	return map[string]taxIdHandler{
+		constTaxIdPt:     checkPtNif,
+		constTaxIdPtNif:  checkPtNif,
+		constTaxIdPtNiss: checkPtNiss,
+		constTaxIdPtCc:   checkPtCc,
+		constTaxIdEs:     checkEsTaxId,
+		constTaxIdEsDni:  checkEsDni,
+		constTaxIdEsNie:  checkEsNie,
+		constTaxIdEsCif:  checkEsCif,
+		constTaxIdBr:     checkBrTaxId,
+		constTaxIdBrCpf:  checkBrCpf,
+		constTaxIdBrCnpj: checkBrCnpj,
+		constTaxIdEuVat:  checkEuVat,
+	}
+}
@@ -0,0 +1,131 @@
+package validator
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func (v *Validator) newHashHandler(hasher Hasher) mutationTagHandler {
+	return func(context *ValidatorContext, validationData *ValidationData) []error {
+		rtnErrs := make([]error, 0)
+
+		_, obj, value := v._getValue(validationData.Value)
+		if !obj.CanAddr() {
+			rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+			return rtnErrs
+		}
+
+		kind := reflect.TypeOf(value).Kind()
+		switch kind {
+		case reflect.String:
+			if value.(string) == "" {
+				return rtnErrs
+			}
+
+			argument, err := v._loadExpectedValue(context, validationData.Expected)
+			if err != nil {
+				rtnErrs = append(rtnErrs, err)
+				return rtnErrs
+			}
+
+			newValue, err := hasher.Hash(value.(string), v._convertToString(argument))
+			if err != nil {
+				rtnErrs = append(rtnErrs, err)
+				return rtnErrs
+			}
+
+			if err = _setValue(kind, obj, newValue); err != nil {
+				rtnErrs = append(rtnErrs, err)
+				return rtnErrs
+			}
+		}
+
+		return rtnErrs
+	}
+}
+
+func (h *sha256Hasher) Hash(value string, argument string) (string, error) {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (h *sha512Hasher) Hash(value string, argument string) (string, error) {
+	sum := sha512.Sum512([]byte(value))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+func (h *hmacHasher) Hash(value string, argument string) (string, error) {
+	key, ok := h.keys[argument]
+	if !ok {
+		return "", formatError(ErrorInvalidTagArgument, argument)
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(value))
+
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+func (h *bcryptHasher) Hash(value string, argument string) (string, error) {
+	cost := bcrypt.DefaultCost
+
+	if argument != "" {
+		var err error
+		if cost, err = strconv.Atoi(argument); err != nil {
+			return "", formatError(ErrorInvalidTagArgument, argument)
+		}
+	}
+
+	hash, err := bcrypt.GenerateFromPassword([]byte(value), cost)
+	if err != nil {
+		return "", err
+	}
+
+	return string(hash), nil
+}
+
+// Hash with the arguments time;memory;threads, returning the hash on the PHC string format
+func (h *argon2idHasher) Hash(value string, argument string) (string, error) {
+	params := []uint32{constArgon2idTime, constArgon2idMemory, constArgon2idThreads}
+
+	if argument != "" {
+		for i, arg := range strings.Split(argument, constTagSplitValues) {
+			if i >= len(params) {
+				return "", formatError(ErrorInvalidTagArgument, argument)
+			}
+
+			param, err := strconv.ParseUint(strings.TrimSpace(arg), 10, 32)
+			if err != nil || param == 0 {
+				return "", formatError(ErrorInvalidTagArgument, argument)
+			}
+			params[i] = uint32(param)
+		}
+	}
+
+	if params[2] > 255 {
+		return "", formatError(ErrorInvalidTagArgument, argument)
+	}
+
+	salt := make([]byte, constArgon2idSaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+
+	hash := argon2.IDKey([]byte(value), salt, params[0], params[1], uint8(params[2]), constArgon2idKeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params[1], params[0], params[2],
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(hash)), nil
+}
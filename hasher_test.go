@@ -0,0 +1,132 @@
+package validator
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+type reverseHasher struct{}
+
+func (h *reverseHasher) Hash(value string, argument string) (string, error) {
+	runes := []rune(value)
+	for i, j := 0, len(runes)-1; i < j; i, j = i+1, j-1 {
+		runes[i], runes[j] = runes[j], runes[i]
+	}
+
+	return argument + string(runes), nil
+}
+
+func TestHashers(t *testing.T) {
+	type example struct {
+		Sha256  string `validate:"set-sha256"`
+		Sha512  string `validate:"set-sha512"`
+		Hmac    string `validate:"set-hmac=jefe"`
+		Reverse string `validate:"set-reverse=x"`
+		Empty   string `validate:"set-sha256"`
+	}
+
+	obj := &example{
+		Sha256:  "abc",
+		Sha512:  "abc",
+		Hmac:    "what do ya want for nothing?",
+		Reverse: "abc",
+	}
+
+	validator := NewValidator().
+		AddHmacKey("jefe", []byte("Jefe")).
+		AddHasher("reverse", &reverseHasher{})
+
+	if errs := validator.Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := example{
+		Sha256:  "ba7816bf8f01cfea414140de5dae2223b00361a396177a9cb410ff61f20015ad",
+		Sha512:  "ddaf35a193617abacc417349ae20413112e6fa4e89a97ea20a9eeee64b55d39a2192992a274fc1a836ba3c23a3feebbd454d4423643ce80e2a9ac94fa54ca49f",
+		Hmac:    "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		Reverse: "xcba",
+	}
+
+	if *obj != expected {
+		t.Errorf("expected %+v, got %+v", expected, *obj)
+	}
+}
+
+func TestHasherErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  interface{}
+	}{
+		{"missing hmac key", &struct {
+			Value string `validate:"set-hmac=unknown"`
+		}{Value: "a"}},
+		{"invalid bcrypt cost", &struct {
+			Value string `validate:"set-bcrypt=x"`
+		}{Value: "a"}},
+		{"invalid argon2id arguments", &struct {
+			Value string `validate:"set-argon2id=1;0;1"`
+		}{Value: "a"}},
+		{"too many argon2id arguments", &struct {
+			Value string `validate:"set-argon2id=1;64;1;1"`
+		}{Value: "a"}},
+	}
+
+	for _, test := range tests {
+		if errs := NewValidator().Validate(test.obj); len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", test.name, errs)
+		}
+	}
+}
+
+func TestBcryptHasher(t *testing.T) {
+	obj := &struct {
+		Password string `validate:"set-bcrypt=4"`
+	}{Password: "secret"}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if err := bcrypt.CompareHashAndPassword([]byte(obj.Password), []byte("secret")); err != nil {
+		t.Errorf("the hash does not match the password: %s", err)
+	}
+
+	if cost, err := bcrypt.Cost([]byte(obj.Password)); err != nil || cost != 4 {
+		t.Errorf("expected the cost 4, got %d (%v)", cost, err)
+	}
+}
+
+func TestArgon2idHasher(t *testing.T) {
+	obj := &struct {
+		Password string `validate:"set-argon2id=2;64;1"`
+	}{Password: "secret"}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	// $argon2id$v=19$m=64,t=2,p=1$<salt>$<hash>
+	split := strings.Split(obj.Password, "$")
+	if len(split) != 6 || split[1] != "argon2id" || split[3] != "m=64,t=2,p=1" {
+		t.Fatalf("invalid hash format %s", obj.Password)
+	}
+
+	if split[2] != fmt.Sprintf("v=%d", argon2.Version) {
+		t.Errorf("invalid version on the hash %s", obj.Password)
+	}
+
+	salt, err := base64.RawStdEncoding.DecodeString(split[4])
+	if err != nil {
+		t.Fatalf("invalid salt: %s", err)
+	}
+
+	hash := argon2.IDKey([]byte("secret"), salt, 2, 64, 1, constArgon2idKeyLength)
+	if base64.RawStdEncoding.EncodeToString(hash) != split[5] {
+		t.Errorf("the hash does not match the password")
+	}
+}
@@ -0,0 +1,222 @@
+package validator
+
+import (
+	"bytes"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+//go:embed conf/iso3166.csv
+var iso3166File []byte
+
+//go:embed conf/iso4217.csv
+var iso4217File []byte
+
+//go:embed conf/postcodes.json
+var postcodesFile []byte
+
+var (
+	isoTablesOnce  sync.Once
+	isoTablesList  *isoTables
+	isoTablesError error
+)
+
+// loadIsoTables loads the embedded tables of countries, currencies and postcodes
+func loadIsoTables() (*isoTables, error) {
+	isoTablesOnce.Do(func() {
+		tables := &isoTables{
+			countries:  make(map[string]*isoCountry),
+			currencies: make(map[string]*isoCurrency),
+			postcodes:  make(map[string]*regexp.Regexp),
+		}
+
+		var records [][]string
+		if records, isoTablesError = readIsoTable(iso3166File); isoTablesError != nil {
+			return
+		}
+		for _, record := range records {
+			country := &isoCountry{alpha2: record[0], alpha3: record[1], numeric: record[2], name: record[3]}
+			tables.countries[country.alpha2] = country
+			tables.countries[country.alpha3] = country
+			tables.countries[country.numeric] = country
+		}
+
+		if records, isoTablesError = readIsoTable(iso4217File); isoTablesError != nil {
+			return
+		}
+		for _, record := range records {
+			currency := &isoCurrency{code: record[0], numeric: record[1], name: record[2]}
+			tables.currencies[currency.code] = currency
+			if currency.numeric != "" {
+				tables.currencies[currency.numeric] = currency
+			}
+		}
+
+		postcodes := make(map[string]string)
+		if isoTablesError = json.Unmarshal(postcodesFile, &postcodes); isoTablesError != nil {
+			return
+		}
+		for country, pattern := range postcodes {
+			if tables.postcodes[country], isoTablesError = regexp.Compile(pattern); isoTablesError != nil {
+				return
+			}
+		}
+
+		isoTablesList = tables
+	})
+
+	return isoTablesList, isoTablesError
+}
+
+// readIsoTable reads the records of a csv table, without the header
+func readIsoTable(file []byte) ([][]string, error) {
+	records, err := csv.NewReader(bytes.NewReader(file)).ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) > 0 {
+		records = records[1:]
+	}
+
+	return records, nil
+}
+
+// _validateIsoCode validates the string or numeric value with the formats of the tag arguments
+func (v *Validator) _validateIsoCode(validationData *ValidationData, check func(value string, formats ...string) error) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil {
+		return rtnErrs
+	}
+
+	var value string
+	switch obj.Kind() {
+	case reflect.String:
+		value = strings.TrimSpace(obj.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if obj.Int() != 0 {
+			value = fmt.Sprintf("%03d", obj.Int())
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if obj.Uint() != 0 {
+			value = fmt.Sprintf("%03d", obj.Uint())
+		}
+	}
+
+	if value == "" {
+		return rtnErrs
+	}
+
+	var formats []string
+	if expected := v._convertToString(validationData.Expected); expected != "" {
+		formats = strings.Split(expected, constTagSplitValues)
+	}
+
+	if err := check(value, formats...); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
+
+// checkIso3166 checks if the value is a country code with one of the formats (alpha2, alpha3 or numeric)
+func checkIso3166(value string, formats ...string) error {
+	tables, err := loadIsoTables()
+	if err != nil {
+		return err
+	}
+
+	country, ok := tables.countries[strings.ToUpper(value)]
+	if !ok {
+		return formatError(ErrorInvalidCountry, value)
+	}
+
+	if len(formats) == 0 {
+		return nil
+	}
+
+	for _, format := range formats {
+		switch strings.ToLower(format) {
+		case constIsoAlpha2:
+			ok = strings.EqualFold(value, country.alpha2)
+		case constIsoAlpha3:
+			ok = strings.EqualFold(value, country.alpha3)
+		case constIsoNumeric:
+			ok = value == country.numeric
+		default:
+			return formatError(ErrorInvalidTagArgument, format)
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	return formatError(ErrorInvalidCountry, value)
+}
+
+// checkIso4217 checks if the value is a currency code with one of the formats (code or numeric)
+func checkIso4217(value string, formats ...string) error {
+	tables, err := loadIsoTables()
+	if err != nil {
+		return err
+	}
+
+	currency, ok := tables.currencies[strings.ToUpper(value)]
+	if !ok {
+		return formatError(ErrorInvalidCurrency, value)
+	}
+
+	if len(formats) == 0 {
+		return nil
+	}
+
+	for _, format := range formats {
+		switch strings.ToLower(format) {
+		case constIsoCode:
+			ok = strings.EqualFold(value, currency.code)
+		case constIsoNumeric:
+			ok = value == currency.numeric
+		default:
+			return formatError(ErrorInvalidTagArgument, format)
+		}
+
+		if ok {
+			return nil
+		}
+	}
+
+	return formatError(ErrorInvalidCurrency, value)
+}
+
+// checkPostcode checks if the value is a postcode of the country (alpha2, alpha3 or numeric)
+func checkPostcode(value string, country string) error {
+	tables, err := loadIsoTables()
+	if err != nil {
+		return err
+	}
+
+	item, ok := tables.countries[strings.ToUpper(strings.TrimSpace(country))]
+	if !ok {
+		return formatError(ErrorInvalidCountry, country)
+	}
+
+	regex, ok := tables.postcodes[item.alpha2]
+	if !ok {
+		return formatError(ErrorInvalidCountry, item.alpha2)
+	}
+
+	if !regex.MatchString(strings.ToUpper(strings.TrimSpace(value))) {
+		return formatError(ErrorInvalidPostcode, item.alpha2)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"testing"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+func TestIsoCodes(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(value string, formats ...string) error
+		value string
+		args  []string
+		err   *joaosofterrors.Error
+	}{
+		{"iso3166", checkIso3166, "PT", nil, nil},
+		{"iso3166", checkIso3166, "prt", nil, nil},
+		{"iso3166", checkIso3166, "620", nil, nil},
+		{"iso3166", checkIso3166, "PT", []string{constIsoAlpha3, constIsoAlpha2}, nil},
+		{"iso3166", checkIso3166, "PRT", []string{constIsoAlpha2}, ErrorInvalidCountry},
+		{"iso3166", checkIso3166, "XX", nil, ErrorInvalidCountry},
+		{"iso3166", checkIso3166, "PT", []string{"other"}, ErrorInvalidTagArgument},
+		{"iso4217", checkIso4217, "EUR", nil, nil},
+		{"iso4217", checkIso4217, "978", []string{constIsoNumeric}, nil},
+		{"iso4217", checkIso4217, "EUR", []string{constIsoNumeric}, ErrorInvalidCurrency},
+		{"iso4217", checkIso4217, "XYZ", nil, ErrorInvalidCurrency},
+	}
+
+	for _, test := range tests {
+		err := test.check(test.value, test.args...)
+		if test.err == nil && err != nil {
+			t.Errorf("%s %s %v: unexpected error %s", test.name, test.value, test.args, err)
+		} else if test.err != nil && !isErrorCode(err, test.err) {
+			t.Errorf("%s %s %v: expected the error [%s], got %v", test.name, test.value, test.args, test.err, err)
+		}
+	}
+}
+
+func TestPostcode(t *testing.T) {
+	tests := []struct {
+		value   string
+		country string
+		err     *joaosofterrors.Error
+	}{
+		{"1000-001", "PT", nil},
+		{"1000-001", "PRT", nil},
+		{"SW1A 1AA", "gb", nil},
+		{"90210-1234", "840", nil},
+		{"1000001", "PT", ErrorInvalidPostcode},
+		{"1000-001", "XX", ErrorInvalidCountry},
+	}
+
+	for _, test := range tests {
+		err := checkPostcode(test.value, test.country)
+		if test.err == nil && err != nil {
+			t.Errorf("%s [%s]: unexpected error %s", test.value, test.country, err)
+		} else if test.err != nil && !isErrorCode(err, test.err) {
+			t.Errorf("%s [%s]: expected the error [%s], got %v", test.value, test.country, test.err, err)
+		}
+	}
+}
+
+func TestIsoTags(t *testing.T) {
+	type example struct {
+		Country  string `json:"country" validate:"iso3166=alpha2"`
+		Numeric  int    `validate:"iso3166=numeric"`
+		Currency string `validate:"iso4217"`
+		Language string `validate:"bcp47"`
+		Postcode string `validate:"postcode={country}"`
+	}
+
+	tests := []struct {
+		name string
+		obj  *example
+		errs []*joaosofterrors.Error
+	}{
+		{"valid", &example{Country: "PT", Numeric: 620, Currency: "EUR", Language: "pt-PT", Postcode: "1000-001"}, nil},
+		{"zero values", &example{}, nil},
+		{"invalid", &example{Country: "PT", Numeric: 999, Currency: "XYZ", Language: "pt_PT", Postcode: "1000"},
+			[]*joaosofterrors.Error{ErrorInvalidCountry, ErrorInvalidCurrency, ErrorInvalidLanguage, ErrorInvalidPostcode}},
+	}
+
+	for _, test := range tests {
+		errs := NewValidator().SetValidateAll(true).Validate(test.obj)
+		if len(errs) != len(test.errs) {
+			t.Errorf("%s: expected %d errors, got %v", test.name, len(test.errs), errs)
+			continue
+		}
+
+		for i, err := range errs {
+			if !isErrorCode(err, test.errs[i]) {
+				t.Errorf("%s: expected the error [%s], got [%s]", test.name, test.errs[i], err)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+)
+
+// mask replaces all the characters, except the last ones to keep
+func mask(str string, keep int, char rune) string {
+	runes := []rune(str)
+	keep = maskKeep(keep, len(runes))
+
+	for i := 0; i < len(runes)-keep; i++ {
+		runes[i] = char
+	}
+
+	return string(runes)
+}
+
+// maskEmail keeps the first characters of the local part and the domain
+func maskEmail(str string, char rune) string {
+	index := strings.LastIndex(str, "@")
+	if index < 0 {
+		return mask(str, 0, char)
+	}
+
+	local := []rune(str[:index])
+	for i := maskKeep(constMaskEmailKeep, len(local)); i < len(local); i++ {
+		local[i] = char
+	}
+
+	return string(local) + str[index:]
+}
+
+// maskCard keeps the separators and the last digits of the card
+func maskCard(str string, char rune) string {
+	runes := []rune(str)
+
+	digits := 0
+	for _, char := range runes {
+		if unicode.IsDigit(char) {
+			digits++
+		}
+	}
+
+	keep := maskKeep(constMaskCardKeep, digits)
+	for i := len(runes) - 1; i >= 0; i-- {
+		if !unicode.IsDigit(runes[i]) {
+			continue
+		}
+
+		if keep > 0 {
+			keep--
+			continue
+		}
+
+		runes[i] = char
+	}
+
+	return string(runes)
+}
+
+// maskKeep keeps at most half of the characters, so the short values are not shown
+func maskKeep(keep int, length int) int {
+	if keep > length/2 {
+		return length / 2
+	}
+
+	return keep
+}
+
+func maskChar(argument string) rune {
+	if argument == "" {
+		return constMaskChar
+	}
+
+	return []rune(argument)[0]
+}
@@ -0,0 +1,85 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestMaskFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  func(string) string
+		value    string
+		expected string
+	}{
+		{"mask", func(str string) string { return mask(str, 4, '*') }, "123456789", "*****6789"},
+		{"mask", func(str string) string { return mask(str, 0, '#') }, "secret", "######"},
+		{"mask", func(str string) string { return mask(str, 2, '*') }, "çãoéá", "***éá"},
+		{"mask email", func(str string) string { return maskEmail(str, '*') }, "joao@mail.com", "j***@mail.com"},
+		{"mask email", func(str string) string { return maskEmail(str, '*') }, "invalid", "*******"},
+		{"mask card", func(str string) string { return maskCard(str, '*') }, "4111 1111 1111 1234", "**** **** **** 1234"},
+		{"mask card", func(str string) string { return maskCard(str, '*') }, "4111-1111-1111-1234", "****-****-****-1234"},
+		{"mask short", func(str string) string { return mask(str, 4, '*') }, "123", "**3"},
+		{"mask short", func(str string) string { return mask(str, 4, '*') }, "1", "*"},
+		{"mask exact", func(str string) string { return mask(str, 4, '*') }, "1234", "**34"},
+		{"mask empty", func(str string) string { return mask(str, 4, '*') }, "", ""},
+		{"mask email short", func(str string) string { return maskEmail(str, '*') }, "j@mail.com", "*@mail.com"},
+		{"mask email empty", func(str string) string { return maskEmail(str, '*') }, "", ""},
+		{"mask card short", func(str string) string { return maskCard(str, '*') }, "12-34", "**-34"},
+		{"mask card short", func(str string) string { return maskCard(str, '*') }, "123", "**3"},
+		{"mask card empty", func(str string) string { return maskCard(str, '*') }, "", ""},
+	}
+
+	for _, test := range tests {
+		if result := test.convert(test.value); result != test.expected {
+			t.Errorf("%s %q: expected %q, got %q", test.name, test.value, test.expected, result)
+		}
+	}
+}
+
+func TestRedact(t *testing.T) {
+	type example struct {
+		Card     string `validate:"set-mask-card"`
+		Email    string `validate:"set-mask-email"`
+		Phone    string `validate:"set-mask=3;#"`
+		Password string `validate:"set-redact"`
+		Token    string `validate:"set-redact=hidden"`
+		Pin      int    `validate:"set-redact"`
+		Name     string `validate:"not-empty"`
+	}
+
+	obj := example{
+		Card:     "4111 1111 1111 1234",
+		Email:    "joao@mail.com",
+		Phone:    "912345678",
+		Password: "secret",
+		Token:    "token",
+		Pin:      1234,
+	}
+	original := obj
+
+	// the mask tags only run on redact
+	if errs := NewValidator().Validate(&obj); len(errs) != 1 || obj != original {
+		t.Fatalf("expected only the not-empty error and the same object, got %v and %+v", errs, obj)
+	}
+
+	redacted, errs := NewValidator().Redact(&obj)
+	if len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := example{
+		Card:     "**** **** **** 1234",
+		Email:    "j***@mail.com",
+		Phone:    "######678",
+		Password: "[REDACTED]",
+		Token:    "hidden",
+	}
+
+	if result := redacted.(*example); *result != expected {
+		t.Errorf("expected %+v, got %+v", expected, *result)
+	}
+
+	if obj != original {
+		t.Errorf("the redact changed the original, got %+v", obj)
+	}
+}
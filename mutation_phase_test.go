@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMutationPhase(t *testing.T) {
+	type example struct {
+		Name     string `validate:"prefix=A, set-upper"`
+		Password string `validate:"value={confirm}"`
+		Confirm  string `json:"confirm" validate:"set-trim"`
+	}
+
+	tests := []struct {
+		enabled bool
+		obj     example
+		errors  int
+	}{
+		{false, example{Name: "abc", Password: "a", Confirm: "a"}, 1},
+		{true, example{Name: "abc", Password: "a", Confirm: "a"}, 0},
+		{false, example{Name: "ABC", Password: "a", Confirm: " a "}, 1},
+		{true, example{Name: "ABC", Password: "a", Confirm: " a "}, 0},
+	}
+
+	for i, test := range tests {
+		errs := NewValidator().SetValidateAll(true).SetMutationPhase(test.enabled).Validate(&test.obj)
+		if len(errs) != test.errors {
+			t.Errorf("test %d: expected %d errors, got %v", i, test.errors, errs)
+		}
+
+		if test.obj.Name != "ABC" {
+			t.Errorf("test %d: the name was not changed, got [%s]", i, test.obj.Name)
+		}
+	}
+}
+
+func TestMutationPhaseOrder(t *testing.T) {
+	type example struct {
+		First  string `validate:"callback=mark, set-mark"`
+		Second string `validate:"set-mark, callback=mark"`
+	}
+
+	tests := []struct {
+		enabled  bool
+		expected []string
+	}{
+		{false, []string{"validation", "mutation", "mutation", "validation"}},
+		{true, []string{"mutation", "mutation", "validation", "validation"}},
+	}
+
+	for _, test := range tests {
+		var calls []string
+
+		validator := NewValidator().
+			SetMutationPhase(test.enabled).
+			AddMutation("set-mark", func(context *ValidatorContext, validationData *ValidationData) []error {
+				calls = append(calls, "mutation")
+				return nil
+			}).
+			AddCallback("mark", func(context *ValidatorContext, validationData *ValidationData) []error {
+				calls = append(calls, "validation")
+				return nil
+			})
+
+		if errs := validator.Validate(&example{}); len(errs) != 0 {
+			t.Errorf("unexpected errors %v", errs)
+		}
+
+		if !reflect.DeepEqual(calls, test.expected) {
+			t.Errorf("mutation phase %t: expected the calls %v, got %v", test.enabled, test.expected, calls)
+		}
+	}
+}
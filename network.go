@@ -0,0 +1,201 @@
+package validator
+
+import (
+	"net"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// _validateNetwork validates the string value, the empty values are ignored
+func (v *Validator) _validateNetwork(validationData *ValidationData, check func(value string) error) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil || obj.Kind() != reflect.String || obj.String() == "" {
+		return rtnErrs
+	}
+
+	if err := check(obj.String()); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
+
+// _validateIpAddress validates the ip of a string or of a net.IP value, the empty values are ignored
+func (v *Validator) _validateIpAddress(validationData *ValidationData, check func(ip net.IP) error) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil {
+		return rtnErrs
+	}
+
+	var ip net.IP
+	switch {
+	case obj.Type() == reflect.TypeOf(net.IP{}):
+		if obj.Len() == 0 {
+			return rtnErrs
+		}
+		ip = obj.Interface().(net.IP)
+	case obj.Kind() == reflect.String:
+		if obj.String() == "" {
+			return rtnErrs
+		}
+		if ip = net.ParseIP(obj.String()); ip == nil {
+			rtnErrs = append(rtnErrs, ErrorInvalidFormat)
+			return rtnErrs
+		}
+	default:
+		return rtnErrs
+	}
+
+	if err := check(ip); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
+
+// checkCidr checks the ip and the prefix length of the network, with the version 4, 6 or any (0)
+func checkCidr(value string, version int) error {
+	ip, _, err := net.ParseCIDR(value)
+	if err != nil {
+		return ErrorInvalidFormat
+	}
+
+	isV4 := ip.To4() != nil && !strings.Contains(value, ":")
+	if (version == 4 && !isV4) || (version == 6 && isV4) {
+		return ErrorInvalidFormat
+	}
+
+	return nil
+}
+
+func checkMac(value string) error {
+	if _, err := net.ParseMAC(value); err != nil {
+		return ErrorInvalidFormat
+	}
+
+	return nil
+}
+
+// checkHostname checks the hostname with the rules of the RFC 1123
+func checkHostname(value string) error {
+	if len(value) > constHostnameMaxLength {
+		return formatError(ErrorInvalidHostname, value)
+	}
+
+	for _, label := range strings.Split(value, ".") {
+		if !isHostnameLabel(label) {
+			return formatError(ErrorInvalidHostname, value)
+		}
+	}
+
+	return nil
+}
+
+// checkFqdn checks the hostname with a top level domain, with an optional trailing dot
+func checkFqdn(value string) error {
+	name := strings.TrimSuffix(value, ".")
+	if err := checkHostname(name); err != nil {
+		return formatError(ErrorInvalidHostname, value)
+	}
+
+	labels := strings.Split(name, ".")
+	if len(labels) < 2 {
+		return formatError(ErrorInvalidHostname, value)
+	}
+
+	// the top level domain has letters
+	tld := labels[len(labels)-1]
+	if len(tld) < 2 || isDigits(strings.ReplaceAll(tld, "-", "")) {
+		return formatError(ErrorInvalidHostname, value)
+	}
+
+	return nil
+}
+
+func isHostnameLabel(label string) bool {
+	if len(label) == 0 || len(label) > constHostnameMaxLabelLength {
+		return false
+	}
+
+	if label[0] == '-' || label[len(label)-1] == '-' {
+		return false
+	}
+
+	for _, ch := range label {
+		if !(ch >= 'a' && ch <= 'z') && !(ch >= 'A' && ch <= 'Z') && !(ch >= '0' && ch <= '9') && ch != '-' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func checkPort(value string) error {
+	port, err := strconv.Atoi(value)
+	if err != nil || !isDigits(value) || port < constPortMin || port > constPortMax {
+		return formatError(ErrorInvalidPort, value)
+	}
+
+	return nil
+}
+
+// checkHostPort checks the host (hostname or ip) and the port, the ipv6 must be enclosed in brackets
+func checkHostPort(value string) error {
+	host, port, err := net.SplitHostPort(value)
+	if err != nil {
+		return ErrorInvalidFormat
+	}
+
+	if err = checkPort(port); err != nil {
+		return err
+	}
+
+	if net.ParseIP(host) != nil {
+		return nil
+	}
+
+	return checkHostname(host)
+}
+
+// checkIpIn checks if the ip is on one of the networks
+func checkIpIn(ip net.IP, networks []*net.IPNet) error {
+	names := make([]string, 0, len(networks))
+	for _, network := range networks {
+		if network.Contains(ip) {
+			return nil
+		}
+		names = append(names, network.String())
+	}
+
+	return formatError(ErrorInvalidIpRange, ip.String(), strings.Join(names, constTagSplitValues))
+}
+
+func checkIpPrivate(ip net.IP) error {
+	if !ip.IsPrivate() {
+		return formatError(ErrorInvalidIpType, ip.String(), constIpTypePrivate)
+	}
+
+	return nil
+}
+
+// checkIpPublic checks if the ip is a global unicast address, that is not private
+func checkIpPublic(ip net.IP) error {
+	if !ip.IsGlobalUnicast() || ip.IsPrivate() {
+		return formatError(ErrorInvalidIpType, ip.String(), constIpTypePublic)
+	}
+
+	return nil
+}
+
+func checkIpLoopback(ip net.IP) error {
+	if !ip.IsLoopback() {
+		return formatError(ErrorInvalidIpType, ip.String(), constIpTypeLoopback)
+	}
+
+	return nil
+}
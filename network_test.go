@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"net"
+	"testing"
+)
+
+func TestNetworkChecks(t *testing.T) {
+	tests := []struct {
+		name  string
+		check func(value string) error
+		valid []string
+		wrong []string
+	}{
+		{"cidr", func(value string) error { return checkCidr(value, 0) }, []string{"10.0.0.0/8", "fd00::/8"}, []string{"10.0.0.0", "10.0.0.0/33", "a/8"}},
+		{"cidrv4", func(value string) error { return checkCidr(value, 4) }, []string{"192.168.0.0/16"}, []string{"fd00::/8"}},
+		{"cidrv6", func(value string) error { return checkCidr(value, 6) }, []string{"fd00::/8"}, []string{"192.168.0.0/16"}},
+		{"mac", checkMac, []string{"00:1a:2b:3c:4d:5e", "00-1A-2B-3C-4D-5E"}, []string{"00:1a:2b:3c:4d", "00:1a:2b:3c:4d:zz"}},
+		{"hostname", checkHostname, []string{"my-host", "a", "api.example.com"}, []string{"-host", "host-", "my_host", ""}},
+		{"fqdn", checkFqdn, []string{"api.example.com", "example.com."}, []string{"localhost", "example.123", "a..com"}},
+		{"port", checkPort, []string{"1", "80", "65535"}, []string{"0", "65536", "http"}},
+		{"hostport", checkHostPort, []string{"example.com:80", "10.0.0.1:8080", "[::1]:80"}, []string{"example.com", "::1:80", "example.com:0", "my_host:80"}},
+	}
+
+	for _, test := range tests {
+		for _, value := range test.valid {
+			if err := test.check(value); err != nil {
+				t.Errorf("%s: expected [%s] to be valid, got %s", test.name, value, err)
+			}
+		}
+
+		for _, value := range test.wrong {
+			if err := test.check(value); err == nil {
+				t.Errorf("%s: expected [%s] to be invalid", test.name, value)
+			}
+		}
+	}
+}
+
+func TestIpScopes(t *testing.T) {
+	tests := []struct {
+		ip       string
+		private  bool
+		public   bool
+		loopback bool
+	}{
+		{"10.0.0.1", true, false, false},
+		{"192.168.1.1", true, false, false},
+		{"fd00::1", true, false, false},
+		{"8.8.8.8", false, true, false},
+		{"2001:4860:4860::8888", false, true, false},
+		{"127.0.0.1", false, false, true},
+		{"::1", false, false, true},
+	}
+
+	for _, test := range tests {
+		ip := net.ParseIP(test.ip)
+
+		if (checkIpPrivate(ip) == nil) != test.private {
+			t.Errorf("%s: expected private %t", test.ip, test.private)
+		}
+		if (checkIpPublic(ip) == nil) != test.public {
+			t.Errorf("%s: expected public %t", test.ip, test.public)
+		}
+		if (checkIpLoopback(ip) == nil) != test.loopback {
+			t.Errorf("%s: expected loopback %t", test.ip, test.loopback)
+		}
+	}
+}
+
+func TestNetworkTags(t *testing.T) {
+	type example struct {
+		Network string `json:"network"`
+		Ip      string `validate:"ip-in={network};172.16.0.1"`
+		NetIp   net.IP `validate:"ip-private"`
+		Port    int    `validate:"port"`
+		Address string `validate:"hostport"`
+	}
+
+	tests := []struct {
+		name   string
+		obj    *example
+		errors int
+	}{
+		{"valid", &example{Network: "10.0.0.0/8", Ip: "10.1.2.3", NetIp: net.ParseIP("192.168.0.1"), Port: 8080, Address: "[::1]:80"}, 0},
+		{"address", &example{Network: "10.0.0.0/8", Ip: "172.16.0.1"}, 0},
+		{"zero values", &example{Network: "10.0.0.0/8"}, 0},
+		{"invalid", &example{Network: "10.0.0.0/8", Ip: "11.0.0.1", NetIp: net.ParseIP("8.8.8.8"), Port: 70000, Address: "localhost"}, 4},
+		{"invalid network", &example{Network: "10.0.0.0/33", Ip: "10.0.0.1"}, 1},
+	}
+
+	for _, test := range tests {
+		if errs := NewValidator().SetValidateAll(true).Validate(test.obj); len(errs) != test.errors {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.errors, errs)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package validator
+
+import (
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+var (
+	// latin letters without a decomposition
+	transliterations = map[rune]string{
+		'ß': "ss", 'ẞ': "SS",
+		'Æ': "AE", 'æ': "ae",
+		'Œ': "OE", 'œ': "oe",
+		'Ø': "O", 'ø': "o",
+		'Đ': "D", 'đ': "d",
+		'Ð': "D", 'ð': "d",
+		'Ɖ': "D", 'ɖ': "d",
+		'Þ': "TH", 'þ': "th",
+		'Ł': "L", 'ł': "l",
+		'Ŀ': "L", 'ŀ': "l",
+		'Ħ': "H", 'ħ': "h",
+		'Ŧ': "T", 'ŧ': "t",
+		'Ŋ': "N", 'ŋ': "n",
+		'Ĳ': "IJ", 'ĳ': "ij",
+		'ı': "i", 'ĸ': "k",
+		'ſ': "s", 'ƒ': "f",
+	}
+	normalizationForms = map[string]norm.Form{
+		constNormalizationNFC:  norm.NFC,
+		constNormalizationNFD:  norm.NFD,
+		constNormalizationNFKC: norm.NFKC,
+		constNormalizationNFKD: norm.NFKD,
+	}
+)
+
+func normalizeUnicode(str string, form string) (string, error) {
+	if form == "" {
+		form = constNormalizationNFC
+	}
+
+	normalizer, ok := normalizationForms[strings.ToLower(form)]
+	if !ok {
+		return str, formatError(ErrorInvalidTagArgument, form)
+	}
+
+	return normalizer.String(str), nil
+}
+
+func foldAccents(str string) string {
+	folder := transform.Chain(norm.NFKD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+
+	folded, _, err := transform.String(folder, str)
+	if err != nil {
+		folded = str
+	}
+
+	var builder strings.Builder
+	for _, char := range folded {
+		if replace, ok := transliterations[char]; ok {
+			builder.WriteString(replace)
+		} else {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func toASCII(str string) string {
+	var builder strings.Builder
+
+	for _, char := range foldAccents(str) {
+		if char <= unicode.MaxASCII {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+func collapseSpaces(str string) string {
+	return strings.Join(strings.Fields(str), " ")
+}
+
+func slug(str string, separator string, maxLength int, lower bool) string {
+	var builder strings.Builder
+	pending := false
+
+	for _, char := range toASCII(str) {
+		if unicode.IsLetter(char) || unicode.IsDigit(char) {
+			if pending && builder.Len() > 0 {
+				builder.WriteString(separator)
+			}
+			builder.WriteRune(char)
+			pending = false
+		} else {
+			pending = true
+		}
+	}
+
+	result := builder.String()
+	if lower {
+		result = strings.ToLower(result)
+	}
+
+	if maxLength > 0 && len(result) > maxLength {
+		result = strings.TrimRight(result[:maxLength], separator)
+	}
+
+	return result
+}
@@ -0,0 +1,90 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestNormalizationFunctions(t *testing.T) {
+	tests := []struct {
+		name     string
+		convert  func(string) string
+		value    string
+		expected string
+	}{
+		{"fold accents", foldAccents, "Crème Brûlée", "Creme Brulee"},
+		{"fold accents", foldAccents, "Straße Łódź", "Strasse Lodz"},
+		{"ascii", toASCII, "Ærøskøbing ☃", "AEroskobing "},
+		{"collapse spaces", collapseSpaces, "  a \t b\n\nc  ", "a b c"},
+		{"slug", func(str string) string { return slug(str, "-", 0, true) }, "  Crème Brûlée!! ", "creme-brulee"},
+		{"slug", func(str string) string { return slug(str, "_", 0, true) }, "snake_case value", "snake_case_value"},
+		{"slug", func(str string) string { return slug(str, "-", 8, true) }, "hello big world", "hello-bi"},
+		{"slug", func(str string) string { return slug(str, "-", 6, false) }, "Hello World", "Hello"},
+		{"key", func(str string) string { return convertToKey(str, true) }, "Éá Ñ", "ea-n"},
+		{"key", func(str string) string { return convertToKey(str, false) }, "ÇÀ Ü", "CA-U"},
+	}
+
+	for _, test := range tests {
+		if result := test.convert(test.value); result != test.expected {
+			t.Errorf("%s %q: expected %q, got %q", test.name, test.value, test.expected, result)
+		}
+	}
+}
+
+func TestNormalizeUnicode(t *testing.T) {
+	tests := []struct {
+		form     string
+		value    string
+		expected string
+	}{
+		{"", "e\u0301", "\u00e9"},
+		{"nfc", "e\u0301", "\u00e9"},
+		{"NFD", "\u00e9", "e\u0301"},
+		{"nfkc", "\ufb01", "fi"},
+		{"nfkd", "\u00e9", "e\u0301"},
+	}
+
+	for _, test := range tests {
+		result, err := normalizeUnicode(test.value, test.form)
+		if err != nil || result != test.expected {
+			t.Errorf("%s %q: expected %q, got %q (%v)", test.form, test.value, test.expected, result, err)
+		}
+	}
+
+	if _, err := normalizeUnicode("a", "nfx"); err == nil {
+		t.Error("expected an error for the invalid form")
+	}
+}
+
+func TestNormalizationTags(t *testing.T) {
+	type example struct {
+		Slug    string `validate:"set-slug=_;20"`
+		Ascii   string `validate:"set-ascii"`
+		Spaces  string `validate:"set-collapse-spaces"`
+		Unicode string `validate:"set-normalize-unicode=nfkc"`
+		Name    string `validate:"id=name"`
+		Key     string `validate:"set-key={name}"`
+	}
+
+	obj := &example{
+		Slug:    "Crème Brûlée à la carte",
+		Ascii:   "Ça va?",
+		Spaces:  "  a   b  ",
+		Unicode: "ﬁle",
+		Name:    "Éá Ñ",
+	}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := example{Slug: "creme_brulee_a_la_ca", Ascii: "Ca va?", Spaces: "a b", Unicode: "file", Name: "Éá Ñ", Key: "ea-n"}
+	if *obj != expected {
+		t.Errorf("expected %+v, got %+v", expected, *obj)
+	}
+
+	if errs := NewValidator().Validate(&struct {
+		Value string `validate:"set-slug=-;x"`
+	}{Value: "a"}); len(errs) != 1 {
+		t.Errorf("expected an error for the invalid max length, got %v", errs)
+	}
+}
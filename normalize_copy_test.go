@@ -0,0 +1,63 @@
+package validator
+
+import (
+	"reflect"
+	"testing"
+)
+
+type normalizeExample struct {
+	Name string   `validate:"set-trim, set-upper"`
+	Tags []string `validate:"item:set-trim"`
+	Age  int      `validate:"max=10"`
+}
+
+func newNormalizeExample() normalizeExample {
+	return normalizeExample{Name: " joao ", Tags: []string{" a "}, Age: 20}
+}
+
+func TestValidateAndNormalize(t *testing.T) {
+	expected := normalizeExample{Name: "JOAO", Tags: []string{"a"}, Age: 20}
+
+	tests := []struct {
+		name      string
+		normalize func(normalizeExample) (normalizeExample, []error)
+	}{
+		{"default validator", func(obj normalizeExample) (normalizeExample, []error) {
+			return ValidateAndNormalize(obj)
+		}},
+		{"with validator", func(obj normalizeExample) (normalizeExample, []error) {
+			return ValidateAndNormalizeWith(NewValidator(), obj)
+		}},
+	}
+
+	for _, test := range tests {
+		obj := newNormalizeExample()
+
+		normalized, errs := test.normalize(obj)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", test.name, errs)
+		}
+
+		if !reflect.DeepEqual(normalized, expected) {
+			t.Errorf("%s: expected %+v, got %+v", test.name, expected, normalized)
+		}
+
+		if !reflect.DeepEqual(obj, newNormalizeExample()) {
+			t.Errorf("%s: the input was changed, got %+v", test.name, obj)
+		}
+	}
+}
+
+func TestValidateAndNormalizePointer(t *testing.T) {
+	obj := newNormalizeExample()
+
+	normalized, errs := ValidateAndNormalize(&obj)
+	if len(errs) != 1 {
+		t.Errorf("expected 1 error, got %v", errs)
+	}
+
+	// pointers are validated in place
+	if normalized != &obj || obj.Name != "JOAO" {
+		t.Errorf("expected the same pointer normalized, got %+v", *normalized)
+	}
+}
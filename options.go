@@ -0,0 +1,63 @@
+package validator
+
+import "reflect"
+
+func (h optionHandler) apply(context *ValidatorContext) {
+	h(context)
+}
+
+func (a *argument) apply(context *ValidatorContext) {
+	context.values[constTagArg][a.Id] = &data{
+		value: reflect.ValueOf(a.Value),
+		typ: reflect.StructField{
+			Type: reflect.TypeOf(a.Value),
+		},
+	}
+}
+
+func WithGroups(groups ...string) Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.groups = make(map[string]empty)
+
+		for _, group := range groups {
+			context.groups[group] = empty{}
+		}
+	})
+}
+
+func WithGroupSequence(groups ...string) Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.groupSequence = groups
+	})
+}
+
+func WithFields(fields ...string) Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.fields = newFieldSet(fields)
+	})
+}
+
+func WithoutFields(fields ...string) Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.exceptFields = newFieldSet(fields)
+	})
+}
+
+func DryRun() Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.dryRun = true
+		context.trackChanges = true
+	})
+}
+
+func WithRandomSeed(seed int64) Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.randomSeed = &seed
+	})
+}
+
+func WithNonZero() Option {
+	return optionHandler(func(context *ValidatorContext) {
+		context.nonZero = true
+	})
+}
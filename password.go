@@ -1,104 +1,297 @@
 package validator
 
 import (
-	"bufio"
-	"io"
-	"os"
+	"math"
 	"strings"
 	"unicode"
+
+	"github.com/joaosoft/errors"
 )
 
 func (v *Validator) initPassword() {
-	var err error
-	blackList, err := initPasswordBlackList()
-	if err != nil {
-		v.logger.Info(err)
-	}
+	blackList := newDefaultBlackList()
 
 	v.password = &password{
-		settings: &PasswordSettings{
-			MinNumeric:     constMinNumeric,
-			MinUpper:       constMinUpper,
-			MinLower:       constMinLower,
-			MinLetter:      constMinLetter,
-			MinSpace:       constMinSpace,
-			MinSymbol:      constMinSymbol,
-			MinPunctuation: constMinPunctuation,
-			MinLength:      constMinLength,
-			BlackList:      blackList,
+		blackList: blackList,
+		policies: map[string]*PasswordSettings{
+			constPasswordPolicyDefault: {
+				MinNumeric:     constMinNumeric,
+				MinUpper:       constMinUpper,
+				MinLower:       constMinLower,
+				MinLetter:      constMinLetter,
+				MinSpace:       constMinSpace,
+				MinSymbol:      constMinSymbol,
+				MinPunctuation: constMinPunctuation,
+				MinLength:      constMinLength,
+				MaxRepeat:      constMaxRepeat,
+				MaxSequence:    constMaxSequence,
+				MaxKeyboard:    constMaxKeyboard,
+				MinScore:       constMinScore,
+				BlackList:      blackList,
+			},
+			constPasswordPolicyMedium: {
+				MinNumeric:  1,
+				MinLetter:   1,
+				MinLength:   8,
+				MaxRepeat:   3,
+				MaxSequence: 4,
+				MaxKeyboard: 4,
+				MinScore:    2,
+				BlackList:   blackList,
+			},
+			constPasswordPolicyStrong: {
+				MinNumeric:     1,
+				MinUpper:       1,
+				MinLower:       1,
+				MinLetter:      1,
+				MinPunctuation: 1,
+				MinLength:      12,
+				MaxRepeat:      2,
+				MaxSequence:    3,
+				MaxKeyboard:    3,
+				MinScore:       3,
+				BlackList:      blackList,
+			},
 		},
 	}
 }
-func initPasswordBlackList() (_ map[string]empty, err error) {
-	blackList := make(map[string]empty)
-	var file *os.File
-
-	file, err = os.Open(constPasswordBlackListFile)
-	if err != nil {
-		return blackList, err
-	}
-	defer file.Close()
-
-	reader := bufio.NewReader(file)
-	var line string
-	for {
-		line, err = reader.ReadString('\n')
-		if err != nil && err != io.EOF {
-			break
-		}
 
-		blackList[strings.TrimSuffix(line, "\n")] = empty{}
+func countPassword(value string) *passwordCount {
+	count := &passwordCount{}
 
-		if err != nil {
-			break
-		}
-	}
-	if err != io.EOF {
-		return blackList, err
-	}
-
-	return blackList, nil
-}
-
-func (p *PasswordSettings) check(value string) {
 	for _, ch := range value {
 		switch {
 		case unicode.IsNumber(ch):
-			p.MinNumeric++
+			count.numeric++
 		case unicode.IsUpper(ch):
-			p.MinUpper++
-			p.MinLetter++
+			count.upper++
+			count.letter++
 		case unicode.IsLower(ch):
-			p.MinLower++
-			p.MinLetter++
+			count.lower++
+			count.letter++
 		case ch == ' ':
-			p.MinSpace++
+			count.space++
 		case unicode.IsSymbol(ch):
-			p.MinSymbol++
+			count.symbol++
 		case unicode.IsPunct(ch):
-			p.MinPunctuation++
+			count.punctuation++
+		default:
+			count.other++
 		}
-		p.MinLength++
+		count.length++
 	}
+
+	return count
 }
 
-func (p *PasswordSettings) Compare(value string) (errs []error) {
-	newCheck := &PasswordSettings{}
+// entropy of the password, ignoring the characters that follow a pattern
+func (c *passwordCount) entropy(redundant []bool) float64 {
+	pool := 0
+	if c.numeric > 0 {
+		pool += constPasswordPoolNumeric
+	}
+	if c.upper > 0 {
+		pool += constPasswordPoolLetter
+	}
+	if c.lower > 0 {
+		pool += constPasswordPoolLetter
+	}
+	if c.symbol > 0 || c.punctuation > 0 {
+		pool += constPasswordPoolSymbol
+	}
+	if c.space > 0 {
+		pool += constPasswordPoolSpace
+	}
+	if c.other > 0 {
+		pool += constPasswordPoolOther
+	}
 
-	newCheck.check(value)
+	length := 0
+	for _, isRedundant := range redundant {
+		if !isRedundant {
+			length++
+		}
+	}
 
-	if newCheck.MinNumeric < p.MinNumeric ||
-		newCheck.MinUpper < p.MinUpper ||
-		newCheck.MinLower < p.MinLower ||
-		newCheck.MinLetter < p.MinLetter ||
-		newCheck.MinSpace < p.MinSpace ||
-		newCheck.MinSymbol < p.MinSymbol ||
-		newCheck.MinPunctuation < p.MinPunctuation {
-		return []error{ErrorInvalidValue}
+	if pool == 0 || length == 0 {
+		return 0
 	}
 
-	if _, ok := p.BlackList[strings.ToLower(strings.TrimSpace(value))]; ok {
-		return []error{ErrorInvalidValue}
+	return float64(length) * math.Log2(float64(pool))
+}
+
+// longestRun returns the longest run of characters where each one follows the previous,
+// marking the characters after the first one of each pattern as redundant
+func longestRun(runes []rune, redundant []bool, follows func(previous, current rune) bool) int {
+	longest := 0
+	start := 0
+
+	for i := range runes {
+		if i == 0 || !follows(runes[i-1], runes[i]) {
+			start = i
+		}
+
+		length := i - start + 1
+		if length > longest {
+			longest = length
+		}
+
+		if length == constPasswordPatternLength {
+			for j := start + 1; j <= i; j++ {
+				redundant[j] = true
+			}
+		} else if length > constPasswordPatternLength {
+			redundant[i] = true
+		}
+	}
+
+	return longest
+}
+
+func longestRuns(runes []rune, redundant []bool, follows func(step int) func(previous, current rune) bool) int {
+	ascending := longestRun(runes, redundant, follows(1))
+	descending := longestRun(runes, redundant, follows(-1))
+
+	if ascending > descending {
+		return ascending
+	}
+	return descending
+}
+
+func isRepeated(previous, current rune) bool {
+	return previous == current
+}
+
+// isSequential checks letters and numbers like abcd or 1234
+func isSequential(step int) func(previous, current rune) bool {
+	return func(previous, current rune) bool {
+		previous, current = unicode.ToLower(previous), unicode.ToLower(current)
+
+		if !(unicode.IsLetter(previous) && unicode.IsLetter(current)) &&
+			!(unicode.IsNumber(previous) && unicode.IsNumber(current)) {
+			return false
+		}
+
+		return int(current-previous) == step
+	}
+}
+
+// isKeyboardSequential checks the keys that are side by side on a keyboard row, like qwerty
+func isKeyboardSequential(step int) func(previous, current rune) bool {
+	return func(previous, current rune) bool {
+		previous, current = unicode.ToLower(previous), unicode.ToLower(current)
+
+		for _, row := range passwordKeyboardRows {
+			i := strings.IndexRune(row, previous)
+			j := strings.IndexRune(row, current)
+
+			if i >= 0 && j >= 0 && j-i == step {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+func passwordScore(entropy float64) int {
+	score := 0
+	for _, minEntropy := range passwordScoreEntropies {
+		if entropy >= minEntropy {
+			score++
+		}
+	}
+
+	return score
+}
+
+// Evaluate checks all the rules of the policy and the strength of the password,
+// that can not contain the user values, like the username or the email
+func (p *PasswordSettings) Evaluate(value string, userValues ...string) *PasswordStrength {
+	strength := &PasswordStrength{
+		Rules: make([]*PasswordRule, 0),
+	}
+
+	runes := []rune(value)
+	redundant := make([]bool, len(runes))
+	count := countPassword(value)
+
+	repeat := longestRun(runes, redundant, isRepeated)
+	sequence := longestRuns(runes, redundant, isSequential)
+	keyboard := longestRuns(runes, redundant, isKeyboardSequential)
+	blackListed := p.BlackList != nil && p.BlackList.Contains(strings.ToLower(strings.TrimSpace(value)))
+	personal := containsUserContext(value, userValues)
+
+	if !blackListed && !personal {
+		strength.Entropy = count.entropy(redundant)
+	}
+	strength.Score = passwordScore(strength.Entropy)
+
+	strength.add(constPasswordCheckLength, count.length >= p.MinLength, ErrorPasswordLength, p.MinLength)
+	strength.add(constPasswordCheckNumber, count.numeric >= p.MinNumeric, ErrorPasswordNumeric, p.MinNumeric)
+	strength.add(constPasswordCheckLetter, count.letter >= p.MinLetter, ErrorPasswordLetter, p.MinLetter)
+	strength.add(constPasswordCheckUpper, count.upper >= p.MinUpper, ErrorPasswordUpper, p.MinUpper)
+	strength.add(constPasswordCheckLower, count.lower >= p.MinLower, ErrorPasswordLower, p.MinLower)
+	strength.add(constPasswordCheckSpace, count.space >= p.MinSpace, ErrorPasswordSpace, p.MinSpace)
+	strength.add(constPasswordCheckSymbol, count.symbol >= p.MinSymbol, ErrorPasswordSymbol, p.MinSymbol)
+	strength.add(constPasswordCheckPunctuation, count.punctuation >= p.MinPunctuation, ErrorPasswordPunctuation, p.MinPunctuation)
+	strength.add(constPasswordCheckBlackList, !blackListed, ErrorPasswordBlackList)
+	strength.add(constPasswordCheckUserContext, !personal, ErrorPasswordUserContext)
+	strength.add(constPasswordCheckRepeat, p.MaxRepeat <= 0 || repeat <= p.MaxRepeat, ErrorPasswordRepeat, p.MaxRepeat)
+	strength.add(constPasswordCheckSequence, p.MaxSequence <= 0 || sequence <= p.MaxSequence, ErrorPasswordSequence, p.MaxSequence)
+	strength.add(constPasswordCheckKeyboard, p.MaxKeyboard <= 0 || keyboard <= p.MaxKeyboard, ErrorPasswordKeyboard, p.MaxKeyboard)
+	strength.add(constPasswordCheckScore, strength.Score >= p.MinScore, ErrorPasswordScore, strength.Score, p.MinScore)
+
+	return strength
+}
+
+func (p *PasswordSettings) Compare(value string, userValues ...string) (errs []error) {
+	return p.Evaluate(value, userValues...).Errors()
+}
+
+// containsUserContext checks if the password has the user values or their words, like the local part of the email
+func containsUserContext(value string, userValues []string) bool {
+	value = strings.ToLower(value)
+
+	for _, userValue := range userValues {
+		userValue = strings.ToLower(strings.TrimSpace(userValue))
+		if index := strings.LastIndex(userValue, "@"); index > 0 {
+			userValue = userValue[:index]
+		}
+
+		words := strings.FieldsFunc(userValue, func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+		})
+
+		for _, word := range append(words, userValue) {
+			if len([]rune(word)) >= constPasswordUserContextLength && strings.Contains(value, word) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func (s *PasswordStrength) add(name string, passed bool, err *errors.Error, values ...interface{}) {
+	rule := &PasswordRule{
+		Name:   name,
+		Passed: passed,
+	}
+
+	if !passed {
+		rule.Err = formatError(err, values...)
+	}
+
+	s.Rules = append(s.Rules, rule)
+}
+
+// Errors of the rules that failed
+func (s *PasswordStrength) Errors() (errs []error) {
+	for _, rule := range s.Rules {
+		if !rule.Passed {
+			errs = append(errs, rule.Err)
+		}
 	}
 
 	return errs
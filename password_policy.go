@@ -0,0 +1,80 @@
+package validator
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// load the password policies of a json or yaml file
+func (p *password) load(path string) (map[string]*PasswordSettings, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make(map[string]*passwordPolicy)
+
+	switch extension := strings.ToLower(filepath.Ext(path)); extension {
+	case constPasswordPolicyFormatJson:
+		err = json.Unmarshal(content, &policies)
+	case constPasswordPolicyFormatYaml, constPasswordPolicyFormatYml:
+		err = yaml.Unmarshal(content, &policies)
+	default:
+		err = formatError(ErrorInvalidFileFormat, extension)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	settings := make(map[string]*PasswordSettings)
+	for name, policy := range policies {
+		if settings[name], err = p.newSettings(policy); err != nil {
+			return nil, err
+		}
+	}
+
+	return settings, nil
+}
+
+// newSettings uses the default black list when the policy has none
+func (p *password) newSettings(policy *passwordPolicy) (*PasswordSettings, error) {
+	if policy == nil {
+		policy = &passwordPolicy{}
+	}
+
+	blackList := p.blackList
+
+	if policy.BlackListFile != "" || len(policy.BlackList) > 0 {
+		list := newMemoryBlackList()
+
+		if policy.BlackListFile != "" {
+			if err := list.addFile(policy.BlackListFile); err != nil {
+				return nil, err
+			}
+		}
+
+		list.add(policy.BlackList...)
+		blackList = list
+	}
+
+	return &PasswordSettings{
+		MinNumeric:     policy.MinNumeric,
+		MinLetter:      policy.MinLetter,
+		MinUpper:       policy.MinUpper,
+		MinLower:       policy.MinLower,
+		MinSpace:       policy.MinSpace,
+		MinSymbol:      policy.MinSymbol,
+		MinPunctuation: policy.MinPunctuation,
+		MinLength:      policy.MinLength,
+		MaxRepeat:      policy.MaxRepeat,
+		MaxSequence:    policy.MaxSequence,
+		MaxKeyboard:    policy.MaxKeyboard,
+		MinScore:       policy.MinScore,
+		BlackList:      blackList,
+	}, nil
+}
@@ -0,0 +1,79 @@
+package validator
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadPasswordPolicies(t *testing.T) {
+	dir := t.TempDir()
+
+	files := map[string]string{
+		"policies.json": `{"admin": {"min_length": 14, "min_upper": 1, "black_list": ["Company2020!Secret"]}}`,
+		"policies.yaml": "admin:\n  min_length: 14\n  min_upper: 1\n  black_list_file: " + filepath.Join(dir, "black_list.txt") + "\n",
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "black_list.txt"), []byte("company2020!secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type example struct {
+		Password string `validate:"password=admin"`
+	}
+
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		validator := NewValidator()
+		if err := validator.LoadPasswordPolicies(path); err != nil {
+			t.Fatalf("%s: unexpected error %s", name, err)
+		}
+
+		if errs := validator.Validate(&example{Password: "Tr0ub4dor&3xyz"}); len(errs) != 0 {
+			t.Errorf("%s: unexpected errors %v", name, errs)
+		}
+
+		errs := validator.Validate(&example{Password: "short"})
+		if len(errs) != 2 || !isErrorCode(errs[0], ErrorPasswordLength) || !isErrorCode(errs[1], ErrorPasswordUpper) {
+			t.Errorf("%s: expected the length and upper errors, got %v", name, errs)
+		}
+
+		errs = validator.Validate(&example{Password: "Company2020!Secret"})
+		if len(errs) != 1 || !isErrorCode(errs[0], ErrorPasswordBlackList) {
+			t.Errorf("%s: expected the error [%s], got %v", name, ErrorPasswordBlackList, errs)
+		}
+	}
+}
+
+func TestLoadPasswordPoliciesErrors(t *testing.T) {
+	dir := t.TempDir()
+
+	path := filepath.Join(dir, "policies.txt")
+	if err := os.WriteFile(path, []byte("admin"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := NewValidator().LoadPasswordPolicies(path); !isErrorCode(err, ErrorInvalidFileFormat) {
+		t.Errorf("expected the error [%s], got %v", ErrorInvalidFileFormat, err)
+	}
+
+	if err := NewValidator().LoadPasswordPolicies(filepath.Join(dir, "missing.json")); err == nil {
+		t.Errorf("expected an error for the missing file")
+	}
+}
+
+func TestRegisterPasswordPolicy(t *testing.T) {
+	validator := NewValidator().RegisterPasswordPolicy("pin", &PasswordSettings{MinNumeric: 4, MinLength: 4})
+
+	errs := validator.Validate(&struct {
+		Pin string `validate:"password=pin"`
+	}{Pin: "12a"})
+
+	if len(errs) != 2 || !isErrorCode(errs[0], ErrorPasswordLength) || !isErrorCode(errs[1], ErrorPasswordNumeric) {
+		t.Errorf("expected the length and numeric errors, got %v", errs)
+	}
+}
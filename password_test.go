@@ -0,0 +1,117 @@
+package validator
+
+import (
+	"testing"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+type passwordExample struct {
+	Default string `validate:"password"`
+	Medium  string `validate:"password=medium"`
+	Strong  string `validate:"password=strong"`
+	Unknown string `validate:"password=unknown"`
+}
+
+func TestPassword(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  *passwordExample
+		errs []*joaosofterrors.Error
+	}{
+		{"valid", &passwordExample{Strong: "Tr0ub4dor&3xyz"}, nil},
+		{"default policy", &passwordExample{Default: "ab1"}, []*joaosofterrors.Error{ErrorPasswordLength, ErrorPasswordUpper, ErrorPasswordPunctuation}},
+		{"black list", &passwordExample{Medium: "password"}, []*joaosofterrors.Error{ErrorPasswordNumeric, ErrorPasswordBlackList, ErrorPasswordScore}},
+		{"repeated", &passwordExample{Medium: "aaaa1111"}, []*joaosofterrors.Error{ErrorPasswordRepeat, ErrorPasswordScore}},
+		{"keyboard", &passwordExample{Medium: "qwerty12"}, []*joaosofterrors.Error{ErrorPasswordBlackList, ErrorPasswordKeyboard, ErrorPasswordScore}},
+		{"sequence", &passwordExample{Strong: "abcd1234"}, []*joaosofterrors.Error{ErrorPasswordLength, ErrorPasswordUpper, ErrorPasswordPunctuation,
+			ErrorPasswordBlackList, ErrorPasswordSequence, ErrorPasswordKeyboard, ErrorPasswordScore}},
+		{"unknown policy", &passwordExample{Unknown: "Tr0ub4dor&3xyz"}, []*joaosofterrors.Error{ErrorInvalidTagArgument}},
+	}
+
+	for _, test := range tests {
+		errs := NewValidator().Validate(test.obj)
+		if len(errs) != len(test.errs) {
+			t.Errorf("%s: expected %d errors, got %v", test.name, len(test.errs), errs)
+			continue
+		}
+
+		for i, err := range errs {
+			if !isErrorCode(err, test.errs[i]) {
+				t.Errorf("%s: expected the error [%s], got [%s]", test.name, test.errs[i], err)
+			}
+		}
+	}
+}
+
+func TestEvaluatePassword(t *testing.T) {
+	tests := []struct {
+		password string
+		policy   string
+		score    int
+		failed   []string
+	}{
+		{"Tr0ub4dor&3xyz", "strong", 3, nil},
+		{"S3cure!Passw0rd", "", 4, nil},
+		{"correct horse battery staple", "medium", 4, []string{constPasswordCheckNumber}},
+		{"qwerty12", "strong", 0, []string{constPasswordCheckLength, constPasswordCheckUpper, constPasswordCheckPunctuation,
+			constPasswordCheckBlackList, constPasswordCheckKeyboard, constPasswordCheckScore}},
+	}
+
+	for _, test := range tests {
+		strength, err := NewValidator().EvaluatePassword(test.password, test.policy)
+		if err != nil {
+			t.Fatalf("%s: unexpected error %s", test.password, err)
+		}
+
+		if strength.Score != test.score {
+			t.Errorf("%s: expected the score %d, got %d", test.password, test.score, strength.Score)
+		}
+
+		failed := make([]string, 0)
+		for _, rule := range strength.Rules {
+			if !rule.Passed {
+				failed = append(failed, rule.Name)
+			}
+		}
+
+		if len(failed) != len(test.failed) {
+			t.Errorf("%s: expected the failed rules %v, got %v", test.password, test.failed, failed)
+			continue
+		}
+
+		for i := range failed {
+			if failed[i] != test.failed[i] {
+				t.Errorf("%s: expected the failed rules %v, got %v", test.password, test.failed, failed)
+				break
+			}
+		}
+	}
+
+	if _, err := NewValidator().EvaluatePassword("a", "unknown"); !isErrorCode(err, ErrorInvalidTagArgument) {
+		t.Errorf("expected the error [%s], got %v", ErrorInvalidTagArgument, err)
+	}
+}
+
+func TestNilPasswordSettings(t *testing.T) {
+	v := NewValidator().
+		SetPasswordSettings(nil).
+		RegisterPasswordPolicy("custom", nil).
+		SetPasswordBlackList(NewMemoryBlackList("password"))
+
+	if _, err := v.EvaluatePassword("password", ""); err != nil {
+		t.Errorf("unexpected error %s", err)
+	}
+
+	if _, err := v.EvaluatePassword("password", "custom"); err == nil {
+		t.Error("expected an error for a policy that is not registered")
+	}
+
+	type example struct {
+		Password string `validate:"password"`
+	}
+
+	if errs := v.Validate(&example{Password: "password"}); len(errs) == 0 {
+		t.Error("expected errors for a common password")
+	}
+}
@@ -0,0 +1,11 @@
+package validator
+
+type phase string
+
+// Phases
+const (
+	phaseAll        phase = ""
+	phaseMutation   phase = "mutation"
+	phaseValidation phase = "validation"
+	phaseRedact     phase = "redact"
+)
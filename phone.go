@@ -0,0 +1,206 @@
+package validator
+
+import (
+	_ "embed"
+	"encoding/json"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+//go:embed conf/phone_metadata.json
+var phoneMetadataFile []byte
+
+var (
+	phoneMetadataOnce  sync.Once
+	phoneMetadataList  map[string]*phoneMetadata
+	phoneMetadataError error
+)
+
+// loadPhoneMetadata loads the embedded metadata of the countries, by alpha-2 code
+func loadPhoneMetadata() (map[string]*phoneMetadata, error) {
+	phoneMetadataOnce.Do(func() {
+		metadata := make(map[string]*phoneMetadata)
+		if phoneMetadataError = json.Unmarshal(phoneMetadataFile, &metadata); phoneMetadataError != nil {
+			return
+		}
+
+		for _, country := range metadata {
+			patterns := []struct {
+				name    string
+				pattern string
+			}{
+				{constPhoneTypeMobile, country.Mobile},
+				{constPhoneTypeFixed, country.Fixed},
+				{constPhoneTypeFixedOrMobile, country.FixedOrMobile},
+			}
+
+			for _, item := range patterns {
+				if item.pattern == "" {
+					continue
+				}
+
+				var regex *regexp.Regexp
+				if regex, phoneMetadataError = regexp.Compile(item.pattern); phoneMetadataError != nil {
+					return
+				}
+				country.types = append(country.types, &phoneType{name: item.name, regex: regex})
+			}
+
+			country.areaCodes = make(map[string]bool)
+			for _, areaCode := range country.AreaCodes {
+				country.areaCodes[areaCode] = true
+			}
+		}
+
+		phoneMetadataList = metadata
+	})
+
+	return phoneMetadataList, phoneMetadataError
+}
+
+// ParsePhone parses a phone number on the international format or on the national format of the country,
+// the international numbers of the countries without metadata are checked with the generic E.164 format
+func ParsePhone(value string, country string) (*Phone, error) {
+	metadata, err := loadPhoneMetadata()
+	if err != nil {
+		return nil, err
+	}
+
+	number := strings.NewReplacer(" ", "", "-", "", ".", "", "(", "", ")", "").Replace(strings.TrimSpace(value))
+	country = strings.ToUpper(strings.TrimSpace(country))
+
+	international := false
+	switch {
+	case strings.HasPrefix(number, constPhoneInternationalPrefix):
+		number = number[len(constPhoneInternationalPrefix):]
+		international = true
+	case strings.HasPrefix(number, constPhoneExitCode):
+		number = number[len(constPhoneExitCode):]
+		international = true
+	}
+
+	if !isDigits(number) {
+		return nil, ErrorInvalidFormat
+	}
+
+	if country != "" {
+		if _, ok := metadata[country]; !ok {
+			if err = checkIso3166(country, constIsoAlpha2); err != nil {
+				return nil, err
+			}
+
+			// without metadata, the calling code of the national numbers is unknown
+			if !international {
+				return nil, formatError(ErrorInvalidPhone, country)
+			}
+			return parseE164Phone(number, country)
+		}
+	}
+
+	var countries []string
+	switch {
+	case international && country != "":
+		if !strings.HasPrefix(number, metadata[country].Code) {
+			return nil, formatError(ErrorInvalidPhone, country)
+		}
+		countries = []string{country}
+	case international:
+		// the countries of the calling code
+		for length := 1; length <= constPhoneMaxCallingCode && length < len(number) && len(countries) == 0; length++ {
+			for name, item := range metadata {
+				if item.Code == number[:length] {
+					countries = append(countries, name)
+				}
+			}
+		}
+		sort.Strings(countries)
+
+		if len(countries) == 0 {
+			return parseE164Phone(number, "")
+		}
+	case country != "":
+		countries = []string{country}
+	default:
+		return nil, ErrorInvalidFormat
+	}
+
+	for _, name := range countries {
+		item := metadata[name]
+
+		nationalNumbers := []string{number}
+		if international {
+			nationalNumbers = []string{number[len(item.Code):]}
+		} else if item.Prefix != "" && strings.HasPrefix(number, item.Prefix) {
+			nationalNumbers = append(nationalNumbers, number[len(item.Prefix):])
+		}
+
+		for _, nationalNumber := range nationalNumbers {
+			if !isPhoneAreaCode(metadata, name, nationalNumber) {
+				continue
+			}
+
+			for _, typ := range item.types {
+				if typ.regex.MatchString(nationalNumber) {
+					return &Phone{
+						Country:     name,
+						CallingCode: item.Code,
+						Number:      nationalNumber,
+						E164:        constPhoneInternationalPrefix + item.Code + nationalNumber,
+						Type:        typ.name,
+					}, nil
+				}
+			}
+		}
+	}
+
+	return nil, formatError(ErrorInvalidPhone, strings.Join(countries, constTagSplitValues))
+}
+
+// isPhoneAreaCode checks the area code of the countries that share the calling code,
+// a country without area codes takes the numbers that aren't of the other countries
+func isPhoneAreaCode(metadata map[string]*phoneMetadata, country string, number string) bool {
+	item := metadata[country]
+	if len(number) < constPhoneAreaCodeLength {
+		return len(item.areaCodes) == 0
+	}
+
+	areaCode := number[:constPhoneAreaCodeLength]
+	if len(item.areaCodes) > 0 {
+		return item.areaCodes[areaCode]
+	}
+
+	for name, other := range metadata {
+		if name != country && other.Code == item.Code && other.areaCodes[areaCode] {
+			return false
+		}
+	}
+
+	return true
+}
+
+// parseE164Phone parses the international number with the generic E.164 format,
+// the calling code isn't separated from the number and the type is unknown
+func parseE164Phone(number string, country string) (*Phone, error) {
+	if len(number) < constPhoneE164MinLength || len(number) > constPhoneE164MaxLength || number[0] == '0' {
+		return nil, ErrorInvalidFormat
+	}
+
+	return &Phone{
+		Country: country,
+		Number:  number,
+		E164:    constPhoneInternationalPrefix + number,
+		Type:    constPhoneTypeUnknown,
+	}, nil
+}
+
+// _parsePhone parses the phone with the country of the tag, that can be the value of other field
+func (v *Validator) _parsePhone(context *ValidatorContext, validationData *ValidationData, value string) (*Phone, error) {
+	country, err := v._loadExpectedValue(context, validationData.Expected)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParsePhone(value, v._convertToString(country))
+}
@@ -0,0 +1,97 @@
+package validator
+
+import (
+	"testing"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		value        string
+		country      string
+		phoneCountry string
+		e164         string
+		phoneType    string
+	}{
+		{"+351 912 345 678", "", "PT", "+351912345678", constPhoneTypeMobile},
+		{"00351 212 345 678", "", "PT", "+351212345678", constPhoneTypeFixed},
+		{"912 345 678", "PT", "PT", "+351912345678", constPhoneTypeMobile},
+		{"07911 123456", "GB", "GB", "+447911123456", constPhoneTypeMobile},
+		{"+45 20 12 34 56", "", "DK", "+4520123456", constPhoneTypeFixedOrMobile},
+		{"+1 212 555 0100", "", "US", "+12125550100", constPhoneTypeFixedOrMobile},
+		{"+1 (416) 555-0100", "", "CA", "+14165550100", constPhoneTypeFixedOrMobile},
+		{"1 604 555 0100", "CA", "CA", "+16045550100", constPhoneTypeFixedOrMobile},
+		{"212 555 0100", "US", "US", "+12125550100", constPhoneTypeFixedOrMobile},
+		{"+81 3 1234 5678", "", "", "+81312345678", constPhoneTypeUnknown},
+		{"+81 3 1234 5678", "JP", "JP", "+81312345678", constPhoneTypeUnknown},
+	}
+
+	for _, test := range tests {
+		phone, err := ParsePhone(test.value, test.country)
+		if err != nil {
+			t.Errorf("%s [%s]: unexpected error %s", test.value, test.country, err)
+			continue
+		}
+
+		if phone.Country != test.phoneCountry || phone.E164 != test.e164 || phone.Type != test.phoneType {
+			t.Errorf("%s [%s]: expected %s %s %s, got %s %s %s", test.value, test.country,
+				test.phoneCountry, test.e164, test.phoneType, phone.Country, phone.E164, phone.Type)
+		}
+	}
+}
+
+func TestParsePhoneErrors(t *testing.T) {
+	tests := []struct {
+		value   string
+		country string
+		err     *joaosofterrors.Error
+	}{
+		{"+351 812 345 678", "", ErrorInvalidPhone},
+		{"+351 912 345 678", "ES", ErrorInvalidPhone},
+		{"+1 212 555 0100", "CA", ErrorInvalidPhone},
+		{"+1 416 555 0100", "US", ErrorInvalidPhone},
+		{"416 555 0100", "US", ErrorInvalidPhone},
+		{"03 1234 5678", "JP", ErrorInvalidPhone},
+		{"+351 912 345 678", "XX", ErrorInvalidCountry},
+		{"+81 3 1234 5678", "XX", ErrorInvalidCountry},
+		{"+81 1234 5678 9012 34", "", ErrorInvalidFormat},
+		{"+812", "", ErrorInvalidFormat},
+		{"912 345 678", "", ErrorInvalidFormat},
+		{"+351 91a 345 678", "", ErrorInvalidFormat},
+	}
+
+	for _, test := range tests {
+		if _, err := ParsePhone(test.value, test.country); !isErrorCode(err, test.err) {
+			t.Errorf("%s [%s]: expected error %s, got %v", test.value, test.country, test.err, err)
+		}
+	}
+}
+
+func TestPhoneTags(t *testing.T) {
+	type example struct {
+		Country string `json:"country"`
+		Phone   string `validate:"phone={country}, callback=phone_type"`
+		Mobile  string `validate:"set-e164={country}"`
+	}
+
+	var phoneType interface{}
+	validator := NewValidator().AddCallback("phone_type", func(context *ValidatorContext, validationData *ValidationData) []error {
+		phoneType = validationData.Metadata[constMetadataPhoneType]
+		return nil
+	})
+
+	obj := &example{Country: "PT", Phone: "212 345 678", Mobile: "912-345-678"}
+	if errs := validator.Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if obj.Mobile != "+351912345678" || phoneType != constPhoneTypeFixed {
+		t.Errorf("expected the E.164 phone and the fixed type, got %s and %v", obj.Mobile, phoneType)
+	}
+
+	errs := validator.Validate(&example{Country: "ES", Phone: "212 345 678"})
+	if len(errs) != 1 || !isErrorCode(errs[0], ErrorInvalidPhone) {
+		t.Errorf("expected the error [%s], got %v", ErrorInvalidPhone, errs)
+	}
+}
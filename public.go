@@ -8,6 +8,26 @@ func AddMiddle(name string, handler middleTagHandler) *Validator {
 	return validatorInstance.AddMiddle(name, handler)
 }
 
+func AddMutation(name string, handler mutationTagHandler) *Validator {
+	return validatorInstance.AddMutation(name, handler)
+}
+
+func AddMask(name string, handler mutationTagHandler) *Validator {
+	return validatorInstance.AddMask(name, handler)
+}
+
+func AddTaxId(name string, handler taxIdHandler) *Validator {
+	return validatorInstance.AddTaxId(name, handler)
+}
+
+func AddHasher(name string, hasher Hasher) *Validator {
+	return validatorInstance.AddHasher(name, hasher)
+}
+
+func AddHmacKey(id string, key []byte) *Validator {
+	return validatorInstance.AddHmacKey(id, key)
+}
+
 func AddAfter(name string, handler afterTagHandler) *Validator {
 	return validatorInstance.AddAfter(name, handler)
 }
@@ -16,10 +36,22 @@ func SetErrorCodeHandler(handler errorCodeHandler) *Validator {
 	return validatorInstance.SetErrorCodeHandler(handler)
 }
 
+func SetDefaultErrorCode(tag string, code string) *Validator {
+	return validatorInstance.SetDefaultErrorCode(tag, code)
+}
+
 func SetValidateAll(validate bool) *Validator {
 	return validatorInstance.SetValidateAll(validate)
 }
 
+func SetMutationPhase(enabled bool) *Validator {
+	return validatorInstance.SetMutationPhase(enabled)
+}
+
+func SetRandomSeed(seed int64) *Validator {
+	return validatorInstance.SetRandomSeed(seed)
+}
+
 func SetTag(tag string) *Validator {
 	return validatorInstance.SetTag(tag)
 }
@@ -32,10 +64,58 @@ func SetPasswordSettings(settings *PasswordSettings) *Validator {
 	return validatorInstance.SetPasswordSettings(settings)
 }
 
+func RegisterPasswordPolicy(name string, settings *PasswordSettings) *Validator {
+	return validatorInstance.RegisterPasswordPolicy(name, settings)
+}
+
+func LoadPasswordPolicies(path string) error {
+	return validatorInstance.LoadPasswordPolicies(path)
+}
+
+func SetPasswordBlackList(blackList BlackListProvider) *Validator {
+	return validatorInstance.SetPasswordBlackList(blackList)
+}
+
+func SetBreachProvider(provider BreachProvider) *Validator {
+	return validatorInstance.SetBreachProvider(provider)
+}
+
+func IsPasswordBreached(value string) (int, error) {
+	return validatorInstance.IsPasswordBreached(value)
+}
+
+func EvaluatePassword(value string, policy string, userValues ...string) (*PasswordStrength, error) {
+	return validatorInstance.EvaluatePassword(value, policy, userValues...)
+}
+
 func AddCallback(name string, callback callbackHandler) *Validator {
 	return validatorInstance.AddCallback(name, callback)
 }
 
-func Validate(obj interface{}, args ...*argument) []error {
-	return NewValidatorHandler(validatorInstance, args...).handleValidation(obj)
+func Validate(obj interface{}, options ...Option) []error {
+	return NewValidatorHandler(validatorInstance, options...).handleValidation(obj)
+}
+
+func ValidatePartial(obj interface{}, fields ...string) []error {
+	return validatorInstance.ValidatePartial(obj, fields...)
+}
+
+func ValidateExcept(obj interface{}, fields ...string) []error {
+	return validatorInstance.ValidateExcept(obj, fields...)
+}
+
+func ValidateWithResult(obj interface{}, options ...Option) *Result {
+	return validatorInstance.ValidateWithResult(obj, options...)
+}
+
+func Normalize(obj interface{}, options ...Option) ([]*Change, []error) {
+	return validatorInstance.Normalize(obj, options...)
+}
+
+func Redact(obj interface{}, options ...Option) (interface{}, []error) {
+	return validatorInstance.Redact(obj, options...)
+}
+
+func ValidateAndNormalize[T any](obj T, options ...Option) (T, []error) {
+	return ValidateAndNormalizeWith(validatorInstance, obj, options...)
 }
@@ -0,0 +1,88 @@
+package validator
+
+import (
+	"errors"
+	"testing"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+func TestWarnings(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      interface{}
+		errors   int
+		warnings int
+	}{
+		{
+			name: "warn prefix",
+			obj: &struct {
+				Value string `validate:"warn:max=2"`
+			}{Value: "abc"},
+			warnings: 1,
+		},
+		{
+			name: "severity",
+			obj: &struct {
+				Value string `validate:"severity=warning, max=2, severity=error, min=5"`
+			}{Value: "abc"},
+			errors:   1,
+			warnings: 1,
+		},
+		{
+			name: "warning before error",
+			obj: &struct {
+				Value string `validate:"warn:max=2, prefix=x"`
+			}{Value: "abc"},
+			errors:   1,
+			warnings: 1,
+		},
+		{
+			name: "warnings of fields",
+			obj: &struct {
+				First  string `validate:"warn:max=2"`
+				Second string `validate:"warn:max=2"`
+				Third  string `validate:"min=5"`
+			}{First: "abc", Second: "abc", Third: "abc"},
+			errors:   1,
+			warnings: 2,
+		},
+		{
+			name: "valid",
+			obj: &struct {
+				Value string `validate:"warn:max=5, max=5"`
+			}{Value: "abc"},
+		},
+	}
+
+	// without validating all, the warnings do not stop the validation of the next rules
+	validator := NewValidator().SetValidateAll(false)
+	for _, test := range tests {
+		result := validator.ValidateWithResult(test.obj)
+		if len(result.Errors) != test.errors || len(result.Warnings) != test.warnings {
+			t.Errorf("%s: expected %d errors and %d warnings, got %v and %v", test.name, test.errors, test.warnings, result.Errors, result.Warnings)
+			continue
+		}
+
+		for _, warning := range result.Warnings {
+			var validationErr *ValidationError
+			if !errors.As(warning, &validationErr) || validationErr.Level != joaosofterrors.LevelWarn {
+				t.Errorf("%s: expected a warning, got %v", test.name, warning)
+			}
+		}
+	}
+
+	if errs := validator.Validate(tests[0].obj); len(errs) != 0 {
+		t.Errorf("the warnings are not errors, got %v", errs)
+	}
+}
+
+func TestInvalidSeverity(t *testing.T) {
+	obj := &struct {
+		Value string `validate:"severity=high, max=2"`
+	}{Value: "a"}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 1 {
+		t.Errorf("expected an error for the invalid severity, got %v", errs)
+	}
+}
@@ -1,15 +1,26 @@
 package validator
 
 import (
-	"github.com/joaosoft/logger"
+	"context"
+	"math/rand"
+	"net/http"
 	"reflect"
+	"regexp"
+	"sync"
+
+	"github.com/joaosoft/errors"
+	"github.com/joaosoft/logger"
 )
 
 func (v *Validator) init() {
 	v.handlersBefore = v.newDefaultBeforeHandlers()
 	v.handlersMiddle = v.newDefaultMiddleHandlers()
+	v.handlersMutation = v.newDefaultMutationHandlers()
+	v.handlersMask = v.newDefaultMaskHandlers()
 	v.handlersAfter = v.newDefaultPosHandlers()
 	v.activeHandlers = v.newActiveHandlers()
+	v.handlersTaxId = v.newDefaultTaxIdHandlers()
+	v.sensitiveTags = v.newDefaultSensitiveTags()
 
 	v.initPassword()
 }
@@ -19,17 +30,69 @@ type Validator struct {
 	activeHandlers   map[string]empty
 	handlersBefore   map[string]beforeTagHandler
 	handlersMiddle   map[string]middleTagHandler
+	handlersMutation map[string]mutationTagHandler
+	handlersMask     map[string]mutationTagHandler
 	handlersAfter    map[string]afterTagHandler
+	handlersTaxId    map[string]taxIdHandler
+	sensitiveTags    map[string]empty
 	password         *password
 	errorCodeHandler errorCodeHandler
+	errorCodes       map[string]string
 	callbacks        map[string]callbackHandler
+	hmacKeys         map[string][]byte
+	randomSeed       *int64
 	sanitize         []string
 	logger           logger.ILogger
 	canValidateAll   bool
+	mutationPhase    bool
 }
 
 type password struct {
-	settings *PasswordSettings
+	blackList BlackListProvider
+	breach    BreachProvider
+	policies  map[string]*PasswordSettings
+}
+
+type BreachProvider interface {
+	Range(prefix string) (map[string]int, error)
+}
+
+type directoryBreachProvider struct {
+	path string
+}
+
+type fileBreachProvider struct {
+	path string
+}
+
+type httpBreachProvider struct {
+	ctx    context.Context
+	url    string
+	client *http.Client
+}
+
+type cachedBreachProvider struct {
+	provider BreachProvider
+	size     int
+	cache    map[string]map[string]int
+	mux      sync.Mutex
+}
+
+type passwordPolicy struct {
+	MinNumeric     int      `json:"min_numeric" yaml:"min_numeric"`
+	MinLetter      int      `json:"min_letter" yaml:"min_letter"`
+	MinUpper       int      `json:"min_upper" yaml:"min_upper"`
+	MinLower       int      `json:"min_lower" yaml:"min_lower"`
+	MinSpace       int      `json:"min_space" yaml:"min_space"`
+	MinSymbol      int      `json:"min_symbol" yaml:"min_symbol"`
+	MinPunctuation int      `json:"min_punctuation" yaml:"min_punctuation"`
+	MinLength      int      `json:"min_length" yaml:"min_length"`
+	MaxRepeat      int      `json:"max_repeat" yaml:"max_repeat"`
+	MaxSequence    int      `json:"max_sequence" yaml:"max_sequence"`
+	MaxKeyboard    int      `json:"max_keyboard" yaml:"max_keyboard"`
+	MinScore       int      `json:"min_score" yaml:"min_score"`
+	BlackList      []string `json:"black_list" yaml:"black_list"`
+	BlackListFile  string   `json:"black_list_file" yaml:"black_list_file"`
 }
 
 type PasswordSettings struct {
@@ -41,9 +104,57 @@ type PasswordSettings struct {
 	MinSymbol      int
 	MinPunctuation int
 	MinLength      int
-	BlackList      map[string]empty
+	MaxRepeat      int
+	MaxSequence    int
+	MaxKeyboard    int
+	MinScore       int
+	BlackList      BlackListProvider
+}
+
+type BlackListProvider interface {
+	Contains(value string) bool
 }
 
+type memoryBlackList struct {
+	values map[string]empty
+}
+
+type bloomBlackList struct {
+	bits   []uint64
+	size   uint64
+	hashes uint64
+}
+
+type PasswordStrength struct {
+	Entropy float64
+	Score   int
+	Rules   []*PasswordRule
+}
+
+type PasswordRule struct {
+	Name   string
+	Passed bool
+	Err    error
+}
+
+type passwordCount struct {
+	numeric     int
+	letter      int
+	upper       int
+	lower       int
+	space       int
+	symbol      int
+	punctuation int
+	other       int
+	length      int
+}
+
+type Option interface {
+	apply(context *ValidatorContext)
+}
+
+type optionHandler func(context *ValidatorContext)
+
 type argument struct {
 	Id    string
 	Value interface{}
@@ -58,23 +169,71 @@ func NewArgument(id string, value interface{}) *argument {
 
 type defaultValues map[string]map[string]*data
 
+type Hasher interface {
+	Hash(value string, argument string) (string, error)
+}
+
+type sha256Hasher struct{}
+
+type sha512Hasher struct{}
+
+type hmacHasher struct {
+	keys map[string][]byte
+}
+
+type bcryptHasher struct{}
+
+type argon2idHasher struct{}
+
+type taxIdHandler func(value string) error
+
 type errorCodeHandler func(context *ValidatorContext, validationData *ValidationData) error
 type callbackHandler func(context *ValidatorContext, validationData *ValidationData) []error
 
 type beforeTagHandler func(context *ValidatorContext, validationData *ValidationData) []error
 type middleTagHandler func(context *ValidatorContext, validationData *ValidationData) []error
+type mutationTagHandler func(context *ValidatorContext, validationData *ValidationData) []error
 type afterTagHandler func(context *ValidatorContext, validationData *ValidationData) []error
 
 type empty struct{}
 
 type ValidatorContext struct {
-	validator *Validator
-	values    map[string]map[string]*data
+	validator     *Validator
+	values        map[string]map[string]*data
+	warnings      []error
+	groups        map[string]empty
+	groupSequence []string
+	fields        map[string]empty
+	exceptFields  map[string]empty
+	nonZero       bool
+	trackChanges  bool
+	phase         phase
+	dryRun        bool
+	changes       []*Change
+	randomSeed    *int64
+	random        *rand.Rand
+}
+
+type Result struct {
+	Errors   []error
+	Warnings []error
+	Changes  []*Change
+}
+
+type Change struct {
+	Path string
+	Tag  string
+	Old  interface{}
+	New  interface{}
 }
 
 type baseData struct {
 	Id        string
 	Arguments []interface{}
+	Severity  errors.Level
+	Groups    []string
+	Path      string
+	Metadata  map[string]interface{}
 }
 
 type ValidationData struct {
@@ -83,15 +242,99 @@ type ValidationData struct {
 	Parent         reflect.Value
 	Value          reflect.Value
 	Name           string
+	Tag            string
 	Expected       interface{}
 	ErrorData      *errorData
 	Errors         *[]error
 	ErrorsReplaced map[error]bool
+	errorCode      string
+	severity       errors.Level
+	errorRules     *[]*errorRule
 }
 
 type errorData struct {
 	Code      string
 	Arguments []interface{}
+	Tag       string
+	Expected  interface{}
+	Error     error
+}
+
+type errorRule struct {
+	tag      string
+	expected interface{}
+}
+
+// ValidationError is the error of a failed rule, where Err is the error of the error tag or of the
+// error code handler, when defined, and Original is the error of the rule. Both are returned by Unwrap.
+type ValidationError struct {
+	Field    string
+	Name     string
+	Tag      string
+	Expected interface{}
+	Level    errors.Level
+	Code     string
+	Err      error
+	Original error
+}
+
+type Phone struct {
+	Country     string
+	CallingCode string
+	Number      string
+	E164        string
+	Type        string
+}
+
+type phoneMetadata struct {
+	Code          string   `json:"code"`
+	Prefix        string   `json:"prefix"`
+	Mobile        string   `json:"mobile"`
+	Fixed         string   `json:"fixed"`
+	FixedOrMobile string   `json:"fixed_or_mobile"`
+	AreaCodes     []string `json:"area_codes"`
+	types         []*phoneType
+	areaCodes     map[string]bool
+}
+
+type phoneType struct {
+	name  string
+	regex *regexp.Regexp
+}
+
+type isoTables struct {
+	countries  map[string]*isoCountry
+	currencies map[string]*isoCurrency
+	postcodes  map[string]*regexp.Regexp
+}
+
+type isoCountry struct {
+	alpha2  string
+	alpha3  string
+	numeric string
+	name    string
+}
+
+type isoCurrency struct {
+	code    string
+	numeric string
+	name    string
+}
+
+type cardBrand struct {
+	name     string
+	prefixes [][2]int
+	lengths  []int
+}
+
+type fieldPath struct {
+	name string
+	json string
+}
+
+type cloneKey struct {
+	pointer uintptr
+	typ     reflect.Type
 }
 
 type data struct {
@@ -0,0 +1,397 @@
+package validator
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// cleanTaxId removes the separators used on the documents, like 123.456.789-09 or 12.345.678/0001-95
+func cleanTaxId(value string) string {
+	return strings.NewReplacer(".", "", "/", "").Replace(cleanIdentifier(value))
+}
+
+func digitsOf(value string) []int {
+	digits := make([]int, len(value))
+	for i := range value {
+		digits[i] = int(value[i] - '0')
+	}
+
+	return digits
+}
+
+func allEqual(value string) bool {
+	return strings.Count(value, value[:1]) == len(value)
+}
+
+// checkWeights returns the check digit of the weighted sum mod 11, that is 0 when the rest is lower than 2
+func checkWeights(digits []int, weights []int) int {
+	sum := 0
+	for i, weight := range weights {
+		sum += digits[i] * weight
+	}
+
+	if rest := sum % 11; rest >= 2 {
+		return 11 - rest
+	}
+	return 0
+}
+
+// checkPtNif checks the portuguese tax number (nif/nipc)
+func checkPtNif(value string) error {
+	if len(value) != 9 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !isDigits(value) || !strings.ContainsAny(value[:1], "1235689") && !strings.HasPrefix(value, "45") && !strings.HasPrefix(value, "7") {
+		return ErrorInvalidFormat
+	}
+
+	digits := digitsOf(value)
+	if checkWeights(digits, []int{9, 8, 7, 6, 5, 4, 3, 2}) != digits[8] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkPtNiss checks the portuguese social security number
+func checkPtNiss(value string) error {
+	if len(value) != 11 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !isDigits(value) || (value[0] != '1' && value[0] != '2') {
+		return ErrorInvalidFormat
+	}
+
+	digits := digitsOf(value)
+	sum := 0
+	for i, weight := range []int{29, 23, 19, 17, 13, 11, 7, 5, 3, 2} {
+		sum += digits[i] * weight
+	}
+
+	if 9-sum%10 != digits[10] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkPtCc checks the portuguese citizen card number, like 00000000 0 ZZ4
+func checkPtCc(value string) error {
+	if len(value) != 12 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if matched, _ := regexp.MatchString("^[0-9]{9}[A-Z0-9]{2}[0-9]$", value); !matched {
+		return ErrorInvalidFormat
+	}
+
+	sum := 0
+	double := false
+	for i := len(value) - 1; i >= 0; i-- {
+		digit := strings.IndexByte(constNumericAlphabet+strings.ToUpper(constAsciiLowerAlphabet), value[i])
+		if double {
+			if digit *= 2; digit > 9 {
+				digit -= 9
+			}
+		}
+
+		sum += digit
+		double = !double
+	}
+
+	if sum%10 != 0 {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+func checkEsTaxId(value string) error {
+	if value == "" {
+		return formatError(ErrorInvalidLength, 0)
+	}
+
+	switch {
+	case strings.ContainsAny(value[:1], "XYZ"):
+		return checkEsNie(value)
+	case strings.ContainsAny(value[:1], "ABCDEFGHJNPQRSUVW"):
+		return checkEsCif(value)
+	default:
+		return checkEsDni(value)
+	}
+}
+
+// checkEsDni checks the spanish identity number, like 12345678Z
+func checkEsDni(value string) error {
+	if len(value) != 9 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !isDigits(value[:8]) {
+		return ErrorInvalidFormat
+	}
+
+	number := 0
+	for _, digit := range digitsOf(value[:8]) {
+		number = number*10 + digit
+	}
+
+	if constTaxIdDniLetters[number%23] != value[8] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkEsNie checks the spanish foreigner identity number, like X1234567L
+func checkEsNie(value string) error {
+	if len(value) != 9 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	index := strings.IndexByte("XYZ", value[0])
+	if index < 0 {
+		return ErrorInvalidFormat
+	}
+
+	return checkEsDni(string(rune('0'+index)) + value[1:])
+}
+
+// checkEsCif checks the spanish company tax number, like B12345674
+func checkEsCif(value string) error {
+	if len(value) != 9 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !strings.ContainsAny(value[:1], "ABCDEFGHJNPQRSUVW") || !isDigits(value[1:8]) {
+		return ErrorInvalidFormat
+	}
+
+	sum := 0
+	for i, digit := range digitsOf(value[1:8]) {
+		if i%2 == 0 {
+			digit *= 2
+			digit = digit/10 + digit%10
+		}
+		sum += digit
+	}
+
+	control := (10 - sum%10) % 10
+	digit := byte('0' + control)
+	letter := constTaxIdCifLetters[control]
+
+	switch {
+	case strings.ContainsAny(value[:1], "PQRSNW"):
+		if value[8] != letter {
+			return ErrorInvalidChecksum
+		}
+	case strings.ContainsAny(value[:1], "ABEH"):
+		if value[8] != digit {
+			return ErrorInvalidChecksum
+		}
+	default:
+		if value[8] != digit && value[8] != letter {
+			return ErrorInvalidChecksum
+		}
+	}
+
+	return nil
+}
+
+func checkBrTaxId(value string) error {
+	if len(value) == 14 {
+		return checkBrCnpj(value)
+	}
+
+	return checkBrCpf(value)
+}
+
+// checkBrCpf checks the brazilian individual tax number, like 123.456.789-09
+func checkBrCpf(value string) error {
+	if len(value) != 11 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !isDigits(value) || allEqual(value) {
+		return ErrorInvalidFormat
+	}
+
+	digits := digitsOf(value)
+	if checkWeights(digits, []int{10, 9, 8, 7, 6, 5, 4, 3, 2}) != digits[9] ||
+		checkWeights(digits, []int{11, 10, 9, 8, 7, 6, 5, 4, 3, 2}) != digits[10] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkBrCnpj checks the brazilian company tax number, like 12.345.678/0001-95
+func checkBrCnpj(value string) error {
+	if len(value) != 14 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	if !isDigits(value) || allEqual(value) {
+		return ErrorInvalidFormat
+	}
+
+	digits := digitsOf(value)
+	if checkWeights(digits, []int{5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) != digits[12] ||
+		checkWeights(digits, []int{6, 5, 4, 3, 2, 9, 8, 7, 6, 5, 4, 3, 2}) != digits[13] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkEuVat checks the european vat number with the country code, like PT501964843,
+// with the checksum of AT, BE, DE, ES, FR, IT, NL and PT, and only the format of the other countries
+func checkEuVat(value string) error {
+	if len(value) < 4 {
+		return formatError(ErrorInvalidLength, len(value))
+	}
+
+	country, number := value[:2], value[2:]
+	format, ok := vatFormats[country]
+	if !ok {
+		return formatError(ErrorInvalidCountry, country)
+	}
+
+	if matched, _ := regexp.MatchString(format, number); !matched {
+		return ErrorInvalidFormat
+	}
+
+	switch country {
+	case "AT":
+		return checkAtVat(number)
+	case "BE":
+		return checkBeVat(number)
+	case "DE":
+		return checkDeVat(number)
+	case "ES":
+		return checkEsTaxId(number)
+	case "FR":
+		return checkFrVat(number)
+	case "IT":
+		return checkItVat(number)
+	case "NL":
+		return checkNlVat(number)
+	case "PT":
+		return checkPtNif(number)
+	}
+
+	return nil
+}
+
+// checkAtVat checks the austrian vat number, like U13585627
+func checkAtVat(value string) error {
+	digits := digitsOf(value[1:])
+
+	sum := 0
+	for i, digit := range digits[:7] {
+		if i%2 == 1 {
+			digit = digit/5 + digit*2%10
+		}
+		sum += digit
+	}
+
+	if (10-(sum+4)%10)%10 != digits[7] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkBeVat checks the belgian vat number, like 0403019261
+func checkBeVat(value string) error {
+	number, _ := strconv.Atoi(value[:8])
+	check, _ := strconv.Atoi(value[8:])
+
+	if 97-number%97 != check {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkDeVat checks the german vat number with the iso 7064 mod 11,10, like 136695976
+func checkDeVat(value string) error {
+	digits := digitsOf(value)
+
+	product := 10
+	for _, digit := range digits[:8] {
+		sum := (digit + product) % 10
+		if sum == 0 {
+			sum = 10
+		}
+		product = sum * 2 % 11
+	}
+
+	if (11-product)%10 != digits[8] {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkFrVat checks the french vat number, with the numeric key of the siren, like 40303265045
+func checkFrVat(value string) error {
+	if err := checkLuhn(value[2:]); err != nil {
+		return err
+	}
+
+	// the alphabetic keys have no checksum
+	if !isDigits(value[:2]) {
+		return nil
+	}
+
+	key, _ := strconv.Atoi(value[:2])
+	siren, _ := strconv.Atoi(value[2:])
+
+	if (12+3*(siren%97))%97 != key {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
+
+// checkItVat checks the italian vat number, like 00743110157
+func checkItVat(value string) error {
+	return checkLuhn(value)
+}
+
+// checkNlVat checks the dutch vat number, like 004495445B01, with the mod 11 of the number
+// or the mod 97 of the sole proprietors
+func checkNlVat(value string) error {
+	digits := digitsOf(value[:9])
+
+	sum := 0
+	for i, weight := range []int{9, 8, 7, 6, 5, 4, 3, 2} {
+		sum += digits[i] * weight
+	}
+
+	if sum%11 == digits[8] {
+		return nil
+	}
+
+	// the letters are converted to numbers, like on the iban
+	rest := 0
+	for _, ch := range "NL" + value {
+		number := int(ch - '0')
+		if ch >= 'A' && ch <= 'Z' {
+			number = int(ch-'A') + 10
+			rest = (rest*100 + number) % 97
+			continue
+		}
+		rest = (rest*10 + number) % 97
+	}
+
+	if rest != 1 {
+		return ErrorInvalidChecksum
+	}
+
+	return nil
+}
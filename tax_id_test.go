@@ -0,0 +1,109 @@
+package validator
+
+import (
+	"testing"
+)
+
+func TestTaxIds(t *testing.T) {
+	tests := []struct {
+		name  string
+		valid []string
+		wrong []string
+	}{
+		{constTaxIdPt, []string{"501964843", "123456789"}, []string{"501964842", "401964843", "12345678"}},
+		{constTaxIdPtNif, []string{"501964843"}, []string{"501964844"}},
+		{constTaxIdPtNiss, []string{"11111111110", "20000000001"}, []string{"11111111111", "31111111110", "1111111111"}},
+		{constTaxIdPtCc, []string{"000000000ZZ4", "00000000 0 ZZ4"}, []string{"000000000ZZ5", "00000000ZZ4"}},
+		{constTaxIdEs, []string{"12345678Z", "X1234567L", "A58818501", "B12345674"}, []string{"12345678A", "X1234567A", "A58818502"}},
+		{constTaxIdEsDni, []string{"12345678Z", "00000000T"}, []string{"12345678A", "X1234567L"}},
+		{constTaxIdEsNie, []string{"X1234567L", "Y1234567X", "Z1234567R"}, []string{"X1234567A", "12345678Z"}},
+		{constTaxIdEsCif, []string{"A58818501", "B12345674", "P1234567D"}, []string{"A58818502", "P1234567A", "12345678Z"}},
+		{constTaxIdBr, []string{"123.456.789-09", "11.222.333/0001-81"}, []string{"123.456.789-00", "11.222.333/0001-82", "111.111.111-11"}},
+		{constTaxIdBrCpf, []string{"12345678909"}, []string{"12345678900", "00000000000"}},
+		{constTaxIdBrCnpj, []string{"11222333000181"}, []string{"11222333000182", "11111111111111"}},
+		{
+			constTaxIdEuVat,
+			[]string{"PT501964843", "ESB12345674", "ATU13585627", "BE0403019261", "DE136695976", "FR40303265045", "IT00743110157", "NL004495445B01", "NL000099998B57", "SE556188840401"},
+			[]string{"PT501964844", "ESB12345675", "ATU13585626", "BE0403019262", "DE136695977", "FR41303265045", "IT00743110158", "NL004495446B01", "XX123456789", "PT"},
+		},
+	}
+
+	handlers := NewValidator().handlersTaxId
+	for _, test := range tests {
+		handler, ok := handlers[test.name]
+		if !ok {
+			t.Errorf("%s: handler not found", test.name)
+			continue
+		}
+
+		for _, value := range test.valid {
+			if err := handler(cleanTaxId(value)); err != nil {
+				t.Errorf("%s: expected [%s] to be valid, got %s", test.name, value, err)
+			}
+		}
+
+		for _, value := range test.wrong {
+			if err := handler(cleanTaxId(value)); err == nil {
+				t.Errorf("%s: expected [%s] to be invalid", test.name, value)
+			}
+		}
+
+		// the handlers can not index an empty or short value
+		for _, value := range []string{"", "X", "PT", "A1"} {
+			if err := handler(value); err == nil {
+				t.Errorf("%s: expected [%s] to be invalid", test.name, value)
+			}
+		}
+	}
+}
+
+func TestTaxIdTag(t *testing.T) {
+	type example struct {
+		Country string `json:"country"`
+		TaxId   string `validate:"tax-id={country}"`
+		Any     string `validate:"tax-id=pt;es"`
+		Custom  string `validate:"tax-id=custom"`
+	}
+
+	validator := NewValidator().AddTaxId("CUSTOM", func(value string) error {
+		if value != "ABC" {
+			return ErrorInvalidFormat
+		}
+		return nil
+	})
+
+	tests := []struct {
+		name   string
+		obj    *example
+		errors int
+	}{
+		{"valid", &example{Country: "PT", TaxId: "501 964 843", Any: "12345678-Z", Custom: "abc"}, 0},
+		{"country", &example{Country: "ES", TaxId: "501964843"}, 1},
+		{"any", &example{Country: "PT", Any: "501964844"}, 1},
+		{"custom", &example{Country: "PT", Custom: "abd"}, 1},
+		{"unknown", &example{Country: "XX", TaxId: "501964843"}, 1},
+	}
+
+	for _, test := range tests {
+		if errs := validator.Validate(test.obj); len(errs) != test.errors {
+			t.Errorf("%s: expected %d errors, got %v", test.name, test.errors, errs)
+		}
+	}
+}
+
+func TestTaxIdOnlySeparators(t *testing.T) {
+	type example struct {
+		Es   string `validate:"tax-id=es"`
+		Luhn string `validate:"luhn"`
+	}
+
+	for _, value := range []string{"-", ".", " - ", "./"} {
+		if errs := NewValidator().Validate(&example{Es: value}); len(errs) != 1 {
+			t.Errorf("tax-id: expected an error for [%s], got %v", value, errs)
+		}
+
+		if errs := NewValidator().Validate(&example{Luhn: value}); len(errs) != 1 {
+			t.Errorf("luhn: expected an error for [%s], got %v", value, errs)
+		}
+	}
+}
@@ -0,0 +1,155 @@
+package validator
+
+import (
+	"net"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// checkUrl checks the url as a request uri, and when the schemes are defined,
+// the url must have a host and one of the schemes
+func checkUrl(value string, schemes ...string) error {
+	if len(schemes) == 0 {
+		if _, err := url.ParseRequestURI(value); err != nil {
+			return ErrorInvalidValue
+		}
+		return nil
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" || parsed.Hostname() == "" || strings.ContainsAny(value, constUrlInvalidChars) {
+		return ErrorInvalidValue
+	}
+
+	for _, scheme := range schemes {
+		if strings.EqualFold(parsed.Scheme, strings.TrimSpace(scheme)) {
+			return nil
+		}
+	}
+
+	return formatError(ErrorInvalidScheme, parsed.Scheme)
+}
+
+// checkUri checks the uri reference (absolute or relative) with the characters of the RFC 3986
+func checkUri(value string) error {
+	if _, err := url.Parse(value); err != nil {
+		return ErrorInvalidFormat
+	}
+
+	for i := 0; i < len(value); i++ {
+		ch := value[i]
+		switch {
+		case ch == '%':
+			if i+2 >= len(value) || !isHex(value[i+1]) || !isHex(value[i+2]) {
+				return ErrorInvalidFormat
+			}
+			i += 2
+		case (ch >= 'a' && ch <= 'z') || (ch >= 'A' && ch <= 'Z') || (ch >= '0' && ch <= '9'):
+		case strings.IndexByte(constUriAllowedChars, ch) >= 0:
+		default:
+			return ErrorInvalidFormat
+		}
+	}
+
+	return nil
+}
+
+func isHex(ch byte) bool {
+	return (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'f') || (ch >= 'A' && ch <= 'F')
+}
+
+func checkUrlAbsolute(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return ErrorInvalidFormat
+	}
+
+	if !parsed.IsAbs() {
+		return ErrorUrlNotAbsolute
+	}
+
+	return nil
+}
+
+func checkUrlNoCredentials(value string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return ErrorInvalidFormat
+	}
+
+	if parsed.User != nil {
+		return ErrorUrlCredentials
+	}
+
+	return nil
+}
+
+// checkUrlHostIn checks if the host of the url is one of the hosts, where *.domain allows the subdomains
+func checkUrlHostIn(value string, hosts []string) error {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return ErrorInvalidFormat
+	}
+
+	host := strings.ToLower(parsed.Hostname())
+	for _, allowed := range hosts {
+		allowed = strings.ToLower(strings.TrimSpace(allowed))
+
+		if strings.HasPrefix(allowed, constUrlWildcard) {
+			if strings.HasSuffix(host, allowed[len(constUrlWildcard)-1:]) {
+				return nil
+			}
+			continue
+		}
+
+		if host == allowed {
+			return nil
+		}
+	}
+
+	return formatError(ErrorInvalidHost, host)
+}
+
+// normalizeUrl lowercases the scheme and the host, removes the default port and sorts the query by the key
+func normalizeUrl(value string) (string, error) {
+	parsed, err := url.Parse(value)
+	if err != nil {
+		return "", ErrorInvalidFormat
+	}
+
+	parsed.Scheme = strings.ToLower(parsed.Scheme)
+
+	if parsed.Host != "" {
+		host, port := strings.ToLower(parsed.Hostname()), parsed.Port()
+		if port == urlDefaultPorts[parsed.Scheme] {
+			port = ""
+		}
+
+		switch {
+		case port != "":
+			host = net.JoinHostPort(host, port)
+		case strings.Contains(host, ":"):
+			host = "[" + host + "]"
+		}
+		parsed.Host = host
+	}
+
+	// the pairs of the query are sorted by the key as they are, without decoding and encoding them again
+	if parsed.RawQuery != "" {
+		pairs := strings.Split(parsed.RawQuery, "&")
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return queryKey(pairs[i]) < queryKey(pairs[j])
+		})
+		parsed.RawQuery = strings.Join(pairs, "&")
+	}
+
+	return parsed.String(), nil
+}
+
+func queryKey(pair string) string {
+	if index := strings.IndexByte(pair, '='); index >= 0 {
+		return pair[:index]
+	}
+	return pair
+}
@@ -1,36 +1,21 @@
 package validator
 
 import (
+	"fmt"
+	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 )
 
 var (
-	replaces = map[*regexp.Regexp]string{
-		regexp.MustCompile(`[\xC0-\xC6]`): "A",
-		regexp.MustCompile(`[\xC0-\xC6]`): "A",
-		regexp.MustCompile(`[\xE0-\xE6]`): "a",
-		regexp.MustCompile(`[\xC8-\xCB]`): "E",
-		regexp.MustCompile(`[\xE8-\xEB]`): "e",
-		regexp.MustCompile(`[\xCC-\xCF]`): "I",
-		regexp.MustCompile(`[\xEC-\xEF]`): "i",
-		regexp.MustCompile(`[\xD2-\xD6]`): "O",
-		regexp.MustCompile(`[\xF2-\xF6]`): "o",
-		regexp.MustCompile(`[\xD9-\xDC]`): "U",
-		regexp.MustCompile(`[\xF9-\xFC]`): "u",
-		regexp.MustCompile(`[\xC7-\xE7]`): "c",
-		regexp.MustCompile(`[\xD1]`):      "N",
-		regexp.MustCompile(`[\xF1]`):      "n",
-	}
 	spacereg       = regexp.MustCompile(`\s+`)
 	noncharreg     = regexp.MustCompile(`[^A-Za-z0-9-]`)
 	minusrepeatreg = regexp.MustCompile(`\-{2,}`)
 )
 
 func convertToKey(str string, lower bool) string {
-	for regex, replace := range replaces {
-		str = regex.ReplaceAllString(str, replace)
-	}
+	str = foldAccents(str)
 
 	if lower {
 		str = strings.ToLower(str)
@@ -41,3 +26,28 @@ func convertToKey(str string, lower bool) string {
 
 	return str
 }
+
+// sortedMapKeys returns the keys of the map sorted, so the map is always handled in the same order
+func sortedMapKeys(value reflect.Value) []reflect.Value {
+	keys := value.MapKeys()
+
+	sort.Slice(keys, func(i, j int) bool {
+		a, b := keys[i], keys[j]
+		if a.Kind() == b.Kind() {
+			switch a.Kind() {
+			case reflect.String:
+				return a.String() < b.String()
+			case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+				return a.Int() < b.Int()
+			case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+				return a.Uint() < b.Uint()
+			case reflect.Float32, reflect.Float64:
+				return a.Float() < b.Float()
+			}
+		}
+
+		return fmt.Sprintf("%#v", a.Interface()) < fmt.Sprintf("%#v", b.Interface())
+	})
+
+	return keys
+}
@@ -0,0 +1,30 @@
+package validator
+
+import (
+	"reflect"
+	"strings"
+
+	"golang.org/x/text/language"
+)
+
+func (v *Validator) validate_bcp47(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil || obj.Kind() != reflect.String || obj.String() == "" {
+		return rtnErrs
+	}
+
+	// the subtags are separated by hyphens, the underscores of the posix locales are not allowed
+	value := obj.String()
+	if strings.ContainsAny(value, "_ ") {
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidLanguage, value))
+		return rtnErrs
+	}
+
+	if _, err := language.Parse(value); err != nil {
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidLanguage, value))
+	}
+
+	return rtnErrs
+}
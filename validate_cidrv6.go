@@ -0,0 +1,7 @@
+package validator
+
+func (v *Validator) validate_cidrv6(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateNetwork(validationData, func(value string) error {
+		return checkCidr(value, 6)
+	})
+}
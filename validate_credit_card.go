@@ -0,0 +1,15 @@
+package validator
+
+import "strings"
+
+func (v *Validator) validate_credit_card(context *ValidatorContext, validationData *ValidationData) []error {
+	// arguments: allowed brands
+	var brands []string
+	if expected := v._convertToString(validationData.Expected); expected != "" {
+		brands = strings.Split(expected, constTagSplitValues)
+	}
+
+	return v._validateIdentifier(validationData, func(value string) error {
+		return checkCreditCard(value, brands)
+	})
+}
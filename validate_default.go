@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_default(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	obj := validationData.Value
+	if !obj.IsValid() || !obj.IsZero() {
+		return rtnErrs
+	}
+
+	if !obj.CanSet() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	expected, err := v._loadExpectedValue(context, validationData.Expected)
+	if err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	// values loaded from other fields are set when they have the same type
+	if value := reflect.ValueOf(expected); value.IsValid() && value.Kind() != reflect.String && value.Type().AssignableTo(obj.Type()) {
+		obj.Set(value)
+		return rtnErrs
+	}
+
+	if err = v._setConvertedValue(validationData, obj, v._convertToString(expected), ErrorInvalidDefault); err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"errors"
+	"net"
+	"reflect"
+	"testing"
+	"time"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+func TestDefault(t *testing.T) {
+	type example struct {
+		Name     string            `validate:"default=joao"`
+		Age      int               `validate:"default=30"`
+		Port     uint16            `json:"port" validate:"default=8080"`
+		Enabled  bool              `validate:"default=true"`
+		Rate     float64           `validate:"default=1.5"`
+		Timeout  time.Duration     `validate:"default=1m30s"`
+		Date     time.Time         `validate:"default=2020-01-02T03:04:05Z"`
+		Ip       net.IP            `validate:"default=10.0.0.1"`
+		Tags     []string          `validate:"default=a;b"`
+		Ports    []int             `validate:"default=80;443"`
+		Labels   map[string]int    `validate:"default=a:1;b:2"`
+		Pointer  *int              `validate:"default=5"`
+		Other    uint16            `validate:"default={port}"`
+		Kept     string            `validate:"default=other"`
+		Empty    map[string]string `validate:"default="`
+		Anything interface{}       `validate:"default=text"`
+	}
+
+	obj := &example{Kept: "kept"}
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	pointer := 5
+	expected := &example{
+		Name:     "joao",
+		Age:      30,
+		Port:     8080,
+		Enabled:  true,
+		Rate:     1.5,
+		Timeout:  90 * time.Second,
+		Date:     time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Ip:       net.ParseIP("10.0.0.1"),
+		Tags:     []string{"a", "b"},
+		Ports:    []int{80, 443},
+		Labels:   map[string]int{"a": 1, "b": 2},
+		Pointer:  &pointer,
+		Other:    8080,
+		Kept:     "kept",
+		Empty:    map[string]string{},
+		Anything: "text",
+	}
+
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("expected %+v, got %+v", *expected, *obj)
+	}
+}
+
+func TestDefaultErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		obj  interface{}
+	}{
+		{"int", &struct {
+			Value int `validate:"default=abc"`
+		}{}},
+		{"bool", &struct {
+			Value bool `validate:"default=maybe"`
+		}{}},
+		{"map", &struct {
+			Value map[string]int `validate:"default=a"`
+		}{}},
+		{"unsupported", &struct {
+			Value chan int `validate:"default=1"`
+		}{}},
+	}
+
+	for _, test := range tests {
+		errs := NewValidator().Validate(test.obj)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", test.name, errs)
+			continue
+		}
+
+		var err *joaosofterrors.Error
+		if !errors.As(errs[0], &err) || err.Code != ErrorInvalidDefault.Code {
+			t.Errorf("%s: expected the error [%s], got %v", test.name, ErrorInvalidDefault, errs[0])
+		}
+	}
+}
@@ -0,0 +1,7 @@
+package validator
+
+func (v *Validator) validate_ean(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIdentifier(validationData, func(value string) error {
+		return checkGtin(value, 8, 13)
+	})
+}
@@ -1,15 +1,18 @@
 package validator
 
 import (
-	"errors"
+	goerrors "errors"
 	"regexp"
 	"strings"
+
+	"github.com/joaosoft/errors"
 )
 
 func (v *Validator) validate_error(context *ValidatorContext, validationData *ValidationData) []error {
 	rtnErrs := make([]error, 0)
 	added := make(map[string]bool)
 	var errorList []error
+	var ruleList []*errorRule
 
 	for i, e := range *validationData.Errors {
 		if _, ok := validationData.ErrorsReplaced[e]; ok {
@@ -25,6 +28,8 @@ func (v *Validator) validate_error(context *ValidatorContext, validationData *Va
 			expected = validationData.Expected.(string)
 		}
 
+		rule := validationData.errorRule(i)
+
 		matched, err := regexp.MatchString(constRegexForReplace, expected)
 		if err != nil {
 			rtnErrs = append(rtnErrs, err)
@@ -40,10 +45,11 @@ func (v *Validator) validate_error(context *ValidatorContext, validationData *Va
 
 			strValue := v._convertToString(expected)
 
-			newErr := errors.New(strValue)
+			newErr := v.newValidationError(validationData, rule, "", goerrors.New(strValue), e)
 			(*validationData.Errors)[i] = newErr
 			validationData.ErrorsReplaced[newErr] = true
 			errorList = append(errorList, newErr)
+			ruleList = append(ruleList, rule)
 		} else {
 			replacer := strings.NewReplacer(constTagReplaceStart, "", constTagReplaceEnd, "")
 			expected := replacer.Replace(validationData.Expected.(string))
@@ -66,13 +72,20 @@ func (v *Validator) validate_error(context *ValidatorContext, validationData *Va
 				validationData.ErrorData = &errorData{
 					Code:      split[0],
 					Arguments: arguments,
+					Error:     e,
+				}
+
+				if rule != nil {
+					validationData.ErrorData.Tag = rule.tag
+					validationData.ErrorData.Expected = rule.expected
 				}
 
-				newErr := v.errorCodeHandler(context, validationData)
-				if newErr != nil {
+				if codeErr := v.errorCodeHandler(context, validationData); codeErr != nil {
+					newErr := v.newValidationError(validationData, rule, split[0], codeErr, e)
 					(*validationData.Errors)[i] = newErr
 					validationData.ErrorsReplaced[newErr] = true
 					errorList = append(errorList, newErr)
+					ruleList = append(ruleList, rule)
 				}
 
 				added[split[0]] = true
@@ -81,6 +94,64 @@ func (v *Validator) validate_error(context *ValidatorContext, validationData *Va
 	}
 
 	*validationData.Errors = errorList
+	if validationData.errorRules != nil {
+		*validationData.errorRules = ruleList
+	}
+
+	return rtnErrs
+}
+
+func (v *Validator) newValidationError(validationData *ValidationData, rule *errorRule, code string, err error, original error) *ValidationError {
+	newErr := &ValidationError{
+		Field:    validationData.Field,
+		Name:     validationData.Name,
+		Level:    errors.LevelError,
+		Code:     code,
+		Err:      err,
+		Original: original,
+	}
+
+	if rule != nil {
+		newErr.Tag = rule.tag
+		newErr.Expected = rule.expected
+	}
+
+	return newErr
+}
+
+func (v *Validator) handleErrorCode(context *ValidatorContext, validationData *ValidationData, errs []error) []error {
+	rtnErrs := make([]error, 0, len(errs))
+	rule := &errorRule{
+		tag:      validationData.Tag,
+		expected: validationData.Expected,
+	}
+
+	for _, e := range errs {
+		var err error
+		var original error
+
+		if v.errorCodeHandler != nil {
+			validationData.ErrorData = &errorData{
+				Code:     validationData.errorCode,
+				Tag:      rule.tag,
+				Expected: rule.expected,
+				Error:    e,
+			}
+
+			if err = v.errorCodeHandler(context, validationData); err != nil {
+				original = e
+			}
+		}
+
+		if err == nil {
+			err = e
+		}
+
+		newErr := v.newValidationError(validationData, rule, validationData.errorCode, err, original)
+		newErr.Level = validationData.severity
+
+		rtnErrs = append(rtnErrs, newErr)
+	}
 
 	return rtnErrs
 }
@@ -0,0 +1,12 @@
+package validator
+
+func (v *Validator) validate_groups(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	// the groups are loaded before the execution of the field validations
+	if len(context.getGroups(v._convertToString(validationData.Expected))) == 0 {
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, validationData.Expected))
+	}
+
+	return rtnErrs
+}
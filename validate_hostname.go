@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_hostname(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateNetwork(validationData, checkHostname)
+}
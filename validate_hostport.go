@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_hostport(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateNetwork(validationData, checkHostPort)
+}
@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_iban(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIdentifier(validationData, checkIban)
+}
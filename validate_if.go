@@ -52,7 +52,7 @@ func (v *Validator) validate_if(context *ValidatorContext, validationData *Valid
 
 			if ok {
 				var errs []error
-				err := context.execute(data.typ, data.value, strings.Split(query, " "), &errs)
+				err := context.conditionContext().execute(data.typ, data.value, fieldPath{}, strings.Split(query, " "), &errs)
 
 				// get next operator
 				var op operator
@@ -0,0 +1,37 @@
+package validator
+
+import (
+	"net"
+	"strings"
+)
+
+func (v *Validator) validate_ip_in(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	// arguments: networks or addresses, that can be the value of other field
+	var networks []*net.IPNet
+	for _, argument := range strings.Split(v._convertToString(validationData.Expected), constTagSplitValues) {
+		expected, err := v._loadExpectedValue(context, strings.TrimSpace(argument))
+		if err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+
+		name := v._convertToString(expected)
+		if ip := net.ParseIP(name); ip != nil {
+			networks = append(networks, &net.IPNet{IP: ip, Mask: net.CIDRMask(len(ip)*8, len(ip)*8)})
+			continue
+		}
+
+		_, network, err := net.ParseCIDR(name)
+		if err != nil {
+			rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, name))
+			return rtnErrs
+		}
+		networks = append(networks, network)
+	}
+
+	return v._validateIpAddress(validationData, func(ip net.IP) error {
+		return checkIpIn(ip, networks)
+	})
+}
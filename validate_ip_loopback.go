@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_ip_loopback(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIpAddress(validationData, checkIpLoopback)
+}
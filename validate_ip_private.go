@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_ip_private(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIpAddress(validationData, checkIpPrivate)
+}
@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_ip_public(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIpAddress(validationData, checkIpPublic)
+}
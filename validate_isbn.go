@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_isbn(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIdentifier(validationData, checkIsbn)
+}
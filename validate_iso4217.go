@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_iso4217(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateIsoCode(validationData, checkIso4217)
+}
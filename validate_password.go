@@ -1,5 +1,7 @@
 package validator
 
+import "strings"
+
 func (v *Validator) validate_password(context *ValidatorContext, validationData *ValidationData) (errs []error) {
 	isNil, _, value := v._getValue(validationData.Value)
 	strValue := v._convertToString(value)
@@ -8,5 +10,57 @@ func (v *Validator) validate_password(context *ValidatorContext, validationData
 		return nil
 	}
 
-	return v.password.settings.Compare(strValue)
+	// arguments: policy;breach;{user field};{user field}
+	name := constPasswordPolicyDefault
+	breach := false
+	var userValues []string
+
+	if expected := v._convertToString(validationData.Expected); expected != "" {
+		for i, argument := range strings.Split(expected, constTagSplitValues) {
+			argument = strings.TrimSpace(argument)
+
+			if argument == constPasswordOptionBreach {
+				breach = true
+				continue
+			}
+
+			if i == 0 && !strings.HasPrefix(argument, constTagReplaceIdStart) {
+				if argument != "" {
+					name = argument
+				}
+				continue
+			}
+
+			userValue, err := v._loadExpectedValue(context, argument)
+			if err != nil {
+				return []error{err}
+			}
+
+			// the fields that do not exist are ignored
+			if userValue == argument {
+				continue
+			}
+			userValues = append(userValues, v._convertToString(userValue))
+		}
+	}
+
+	policy, ok := v.password.policies[name]
+	if !ok {
+		return []error{formatError(ErrorInvalidTagArgument, name)}
+	}
+
+	errs = policy.Compare(strValue, userValues...)
+
+	if breach {
+		count, err := v.password.breached(strValue)
+		if err != nil {
+			return append(errs, err)
+		}
+
+		if count > 0 {
+			errs = append(errs, formatError(ErrorPasswordBreached, count))
+		}
+	}
+
+	return errs
 }
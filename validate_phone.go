@@ -0,0 +1,24 @@
+package validator
+
+import "reflect"
+
+func (v *Validator) validate_phone(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil || obj.Kind() != reflect.String || obj.String() == "" {
+		return rtnErrs
+	}
+
+	phone, err := v._parsePhone(context, validationData, obj.String())
+	if err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	// available to the next rules, like callbacks
+	validationData.Metadata[constMetadataPhone] = phone
+	validationData.Metadata[constMetadataPhoneType] = phone.Type
+
+	return rtnErrs
+}
@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+)
+
+func (v *Validator) validate_port(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil {
+		return rtnErrs
+	}
+
+	var value string
+	switch obj.Kind() {
+	case reflect.String:
+		value = obj.String()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if obj.Int() != 0 {
+			value = strconv.FormatInt(obj.Int(), 10)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		if obj.Uint() != 0 {
+			value = strconv.FormatUint(obj.Uint(), 10)
+		}
+	}
+
+	if value == "" {
+		return rtnErrs
+	}
+
+	if err := checkPort(value); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
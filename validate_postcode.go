@@ -0,0 +1,25 @@
+package validator
+
+import "reflect"
+
+func (v *Validator) validate_postcode(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, _ := v._getValue(validationData.Value)
+	if isNil || obj.Kind() != reflect.String || obj.String() == "" {
+		return rtnErrs
+	}
+
+	// the country can be the value of other field
+	country, err := v._loadExpectedValue(context, validationData.Expected)
+	if err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	if err = checkPostcode(obj.String(), v._convertToString(country)); err != nil {
+		rtnErrs = append(rtnErrs, err)
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,28 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_set_ascii(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+
+	switch kind {
+	case reflect.String:
+		newValue := toASCII(value.(string))
+		if err := _setValue(kind, obj, newValue); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,36 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_set_e164(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		if obj.String() == "" {
+			return rtnErrs
+		}
+
+		phone, err := v._parsePhone(context, validationData, obj.String())
+		if err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+
+		if err = _setValue(kind, obj, phone.E164); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,39 @@
+package validator
+
+import (
+	"os"
+	"strings"
+)
+
+func (v *Validator) validate_set_env(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	obj := validationData.Value
+	if !obj.CanSet() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	// arguments: name;required
+	split := strings.Split(v._convertToString(validationData.Expected), constTagSplitValues)
+	name := strings.TrimSpace(split[0])
+	if name == "" {
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, validationData.Expected))
+		return rtnErrs
+	}
+
+	value, ok := os.LookupEnv(name)
+	if !ok {
+		if len(split) > 1 && strings.TrimSpace(split[1]) == constArgumentRequired {
+			rtnErrs = append(rtnErrs, formatError(ErrorMissingEnv, name))
+		}
+		return rtnErrs
+	}
+
+	if err := v._setConvertedValue(validationData, obj, value, ErrorInvalidTypeValue); err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	joaosofterrors "github.com/joaosoft/errors"
+)
+
+// isErrorCode checks if the error, or one of the errors it wraps, has the code of the target
+func isErrorCode(err error, target *joaosofterrors.Error) bool {
+	var validatorErr *joaosofterrors.Error
+	return errors.As(err, &validatorErr) && validatorErr.Code == target.Code
+}
+
+func TestSetEnv(t *testing.T) {
+	type example struct {
+		Host    string        `validate:"set-env=TEST_VALIDATOR_HOST"`
+		Port    int           `validate:"set-env=TEST_VALIDATOR_PORT;required"`
+		Timeout time.Duration `validate:"set-env=TEST_VALIDATOR_TIMEOUT"`
+		Hosts   []string      `validate:"set-env=TEST_VALIDATOR_HOSTS"`
+		Missing string        `validate:"set-env=TEST_VALIDATOR_MISSING"`
+	}
+
+	t.Setenv("TEST_VALIDATOR_HOST", "localhost")
+	t.Setenv("TEST_VALIDATOR_PORT", "8080")
+	t.Setenv("TEST_VALIDATOR_TIMEOUT", "5s")
+	t.Setenv("TEST_VALIDATOR_HOSTS", "a;b")
+
+	obj := &example{Missing: "kept"}
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := &example{Host: "localhost", Port: 8080, Timeout: 5 * time.Second, Hosts: []string{"a", "b"}, Missing: "kept"}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("expected %+v, got %+v", *expected, *obj)
+	}
+}
+
+func TestSetFileContent(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "password"), []byte("secret\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "port"), []byte("5432\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	type example struct {
+		Password string `validate:"set-file-content=${TEST_VALIDATOR_DIR}/password;required"`
+		Port     int    `validate:"set-file-content=${TEST_VALIDATOR_DIR}/port"`
+		Raw      []byte `validate:"set-file-content=${TEST_VALIDATOR_DIR}/password"`
+		Missing  string `validate:"set-file-content=${TEST_VALIDATOR_DIR}/missing"`
+	}
+
+	t.Setenv("TEST_VALIDATOR_DIR", dir)
+
+	obj := &example{}
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := &example{Password: "secret", Port: 5432, Raw: []byte("secret\n")}
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("expected %+v, got %+v", *expected, *obj)
+	}
+}
+
+func TestSetEnvAndFileContentErrors(t *testing.T) {
+	t.Setenv("TEST_VALIDATOR_PORT", "abc")
+
+	tests := []struct {
+		name string
+		obj  interface{}
+		err  *joaosofterrors.Error
+	}{
+		{"missing variable", &struct {
+			Value string `validate:"set-env=TEST_VALIDATOR_MISSING;required"`
+		}{}, ErrorMissingEnv},
+		{"invalid value", &struct {
+			Value int `validate:"set-env=TEST_VALIDATOR_PORT"`
+		}{}, ErrorInvalidTypeValue},
+		{"missing name", &struct {
+			Value string `validate:"set-env"`
+		}{}, ErrorInvalidTagArgument},
+		{"missing file", &struct {
+			Value string `validate:"set-file-content=/missing/validator/file;required"`
+		}{}, ErrorMissingFile},
+	}
+
+	for _, test := range tests {
+		errs := NewValidator().Validate(test.obj)
+		if len(errs) != 1 || !isErrorCode(errs[0], test.err) {
+			t.Errorf("%s: expected the error [%s], got %v", test.name, test.err, errs)
+		}
+	}
+}
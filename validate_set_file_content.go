@@ -0,0 +1,48 @@
+package validator
+
+import (
+	"os"
+	"reflect"
+	"strings"
+)
+
+func (v *Validator) validate_set_file_content(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	obj := validationData.Value
+	if !obj.CanSet() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	// arguments: path;required
+	split := strings.Split(v._convertToString(validationData.Expected), constTagSplitValues)
+	path := os.ExpandEnv(strings.TrimSpace(split[0]))
+	if path == "" {
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, validationData.Expected))
+		return rtnErrs
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if !os.IsNotExist(err) {
+			rtnErrs = append(rtnErrs, err)
+		} else if len(split) > 1 && strings.TrimSpace(split[1]) == constArgumentRequired {
+			rtnErrs = append(rtnErrs, formatError(ErrorMissingFile, path))
+		}
+		return rtnErrs
+	}
+
+	// the bytes are set as they are, the text without the last line break
+	if obj.Kind() == reflect.Slice && obj.Type().Elem().Kind() == reflect.Uint8 {
+		obj.SetBytes(content)
+		return rtnErrs
+	}
+
+	if err = v._setConvertedValue(validationData, obj, strings.TrimRight(string(content), "\r\n"), ErrorInvalidTypeValue); err != nil {
+		rtnErrs = append(rtnErrs, err)
+		return rtnErrs
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,37 @@
+package validator
+
+import "testing"
+
+func TestSetKeyAndSlug(t *testing.T) {
+	type example struct {
+		Value string `validate:"id=value"`
+		Key   string `validate:"set-key={value}"`
+		Slug  string `validate:"set-slug"`
+	}
+
+	tests := []struct {
+		value string
+		key   string
+		slug  string
+	}{
+		{"     aaaaa     3245 79 / ( ) ? =  tem     espaços ...   !!  <<<< ", "aaaaa-3245-79-tem-espacos-", "aaaaa-3245-79-tem-espacos"},
+		{"This is a test", "this-is-a-test", "this-is-a-test"},
+		{"snake_case value", "snakecase-value", "snake-case-value"},
+		{"ÇÀ ÉTÉ Ñú", "ca-ete-nu", "ca-ete-nu"},
+	}
+
+	for _, test := range tests {
+		obj := &example{Value: test.value, Slug: test.value}
+		if errs := NewValidator().Validate(obj); len(errs) != 0 {
+			t.Fatalf("%q: unexpected errors %v", test.value, errs)
+		}
+
+		if obj.Key != test.key {
+			t.Errorf("%q: expected the key [%s], got [%s]", test.value, test.key, obj.Key)
+		}
+
+		if obj.Slug != test.slug {
+			t.Errorf("%q: expected the slug [%s], got [%s]", test.value, test.slug, obj.Slug)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func (v *Validator) validate_set_mask(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		keep := 0
+		char := constMaskChar
+
+		// arguments: characters to keep;mask character
+		if expected := v._convertToString(validationData.Expected); expected != "" {
+			split := strings.SplitN(expected, constTagSplitValues, 2)
+			if split[0] != "" {
+				var err error
+				if keep, err = strconv.Atoi(strings.TrimSpace(split[0])); err != nil || keep < 0 {
+					rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, expected))
+					return rtnErrs
+				}
+			}
+
+			if len(split) > 1 {
+				char = maskChar(split[1])
+			}
+		}
+
+		if err := _setValue(kind, obj, mask(value.(string), keep, char)); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,28 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_set_mask_card(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		char := maskChar(v._convertToString(validationData.Expected))
+
+		if err := _setValue(kind, obj, maskCard(value.(string), char)); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,33 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_set_normalize_unicode(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+
+	switch kind {
+	case reflect.String:
+		newValue, err := normalizeUnicode(value.(string), v._convertToString(validationData.Expected))
+		if err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+
+		if err = _setValue(kind, obj, newValue); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,53 @@
+package validator
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/rand"
+	"reflect"
+	"strings"
+)
+
+func (v *Validator) validate_set_pseudonym(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		if value.(string) == "" {
+			return rtnErrs
+		}
+
+		// arguments: key id;mode
+		split := strings.SplitN(v._convertToString(validationData.Expected), constTagSplitValues, 2)
+		key, ok := v.hmacKeys[split[0]]
+		if !ok {
+			rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, split[0]))
+			return rtnErrs
+		}
+
+		var mode string
+		if len(split) > 1 {
+			mode = split[1]
+		}
+
+		// the same value with the same key has always the same pseudonym
+		mac := hmac.New(sha256.New, key)
+		mac.Write([]byte(value.(string)))
+		random := rand.New(rand.NewSource(int64(binary.BigEndian.Uint64(mac.Sum(nil)))))
+
+		if err := _setValue(kind, obj, v._randomWithMode(random, mode, value.(string))); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -1,7 +1,6 @@
 package validator
 
 import (
-	"math/rand"
 	"reflect"
 )
 
@@ -16,6 +15,7 @@ func (v *Validator) validate_set_random(context *ValidatorContext, validationDat
 
 	kind := reflect.TypeOf(value).Kind()
 	isPointer := false
+	random := context.getRandom()
 
 	if kind == reflect.Ptr && !obj.IsNil() {
 		isPointer = true
@@ -31,11 +31,16 @@ func (v *Validator) validate_set_random(context *ValidatorContext, validationDat
 			return rtnErrs
 		}
 
-		if v._convertToString(expected) == "" {
+		var mode string
+		switch v._convertToString(expected) {
+		case constRandomModeEmail, constRandomModePhone, constRandomModeUUID:
+			mode = v._convertToString(expected)
+			expected = value
+		case "":
 			expected = value
 		}
 
-		if err = _setValue(kind, obj, v._random(v._convertToString(expected))); err != nil {
+		if err = _setValue(kind, obj, v._randomWithMode(random, mode, v._convertToString(expected))); err != nil {
 			rtnErrs = append(rtnErrs, err)
 			return rtnErrs
 		}
@@ -43,16 +48,16 @@ func (v *Validator) validate_set_random(context *ValidatorContext, validationDat
 	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
 		min := 1
 		max := 100
-		obj.SetInt(int64(rand.Intn(max-min) + min))
+		obj.SetInt(int64(random.Intn(max-min) + min))
 
 	case reflect.Float32:
-		obj.SetFloat(float64(rand.Float32()))
+		obj.SetFloat(float64(random.Float32()))
 
 	case reflect.Float64:
-		obj.SetFloat(rand.Float64())
+		obj.SetFloat(random.Float64())
 
 	case reflect.Bool:
-		obj.SetBool(rand.Intn(1) == 1)
+		obj.SetBool(random.Intn(2) == 1)
 	}
 
 	if isPointer {
@@ -0,0 +1,144 @@
+package validator
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+type randomExample struct {
+	Name  string `validate:"set-random"`
+	Email string `validate:"set-random=email"`
+	Phone string `validate:"set-random=phone"`
+	Id    string `validate:"set-random=uuid"`
+	Age   int    `validate:"set-random"`
+}
+
+func newRandomExample() *randomExample {
+	return &randomExample{
+		Name:  "Joao Ribeiro",
+		Email: "joao.ribeiro@mail.com",
+		Phone: "+351 912-345-678",
+		Id:    "6BA7B810-9DAD-11D1-80B4-00C04FD430C8",
+		Age:   30,
+	}
+}
+
+func TestSetRandomSeed(t *testing.T) {
+	first := newRandomExample()
+	if errs := NewValidator().Validate(first, WithRandomSeed(42)); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	second := newRandomExample()
+	if errs := NewValidator().SetRandomSeed(42).Validate(second); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if *first != *second {
+		t.Errorf("expected the same values with the same seed, got %+v and %+v", *first, *second)
+	}
+
+	third := newRandomExample()
+	if errs := NewValidator().Validate(third, WithRandomSeed(43)); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if *first == *third {
+		t.Errorf("expected other values with other seed, got %+v", *third)
+	}
+}
+
+func TestSetRandomModes(t *testing.T) {
+	obj := newRandomExample()
+	if errs := NewValidator().Validate(obj, WithRandomSeed(1)); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	tests := []struct {
+		name  string
+		value string
+		regex string
+	}{
+		{"email", obj.Email, `^[a-z]{4}\.[a-z]{7}@[a-z]{4}\.com$`},
+		{"phone", obj.Phone, `^\+351 [0-9]{3}-[0-9]{3}-[0-9]{3}$`},
+		{"uuid", obj.Id, `^[0-9A-F]{8}-[0-9A-F]{4}-4[0-9A-F]{3}-[89AB][0-9A-F]{3}-[0-9A-F]{12}$`},
+	}
+
+	for _, test := range tests {
+		if !regexp.MustCompile(test.regex).MatchString(test.value) {
+			t.Errorf("%s: the value %q does not keep the shape", test.name, test.value)
+		}
+	}
+
+	if len([]rune(obj.Name)) != len([]rune("Joao Ribeiro")) || !strings.Contains(obj.Name, " ") {
+		t.Errorf("the random value does not keep the shape, got %q", obj.Name)
+	}
+}
+
+func TestSetPseudonym(t *testing.T) {
+	type example struct {
+		First  string `validate:"set-pseudonym=key;email"`
+		Second string `validate:"set-pseudonym=key;email"`
+		Other  string `validate:"set-pseudonym=key;email"`
+	}
+
+	validator := NewValidator().AddHmacKey("key", []byte("secret"))
+
+	obj := &example{First: "joao@mail.com", Second: "joao@mail.com", Other: "maria@mail.com"}
+	if errs := validator.Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	if obj.First != obj.Second || obj.First == obj.Other || obj.First == "joao@mail.com" {
+		t.Errorf("expected the same pseudonym for the same value, got %+v", *obj)
+	}
+
+	if !strings.HasSuffix(obj.First, ".com") || strings.Index(obj.First, "@") != 4 {
+		t.Errorf("the pseudonym does not keep the shape, got %q", obj.First)
+	}
+
+	if errs := validator.Validate(&struct {
+		Value string `validate:"set-pseudonym=unknown"`
+	}{Value: "a"}); len(errs) != 1 {
+		t.Errorf("expected an error for the unknown key, got %v", errs)
+	}
+}
+
+func TestSetRandomSeedOnMaps(t *testing.T) {
+	type example struct {
+		Items map[string]string `validate:"item:set-random"`
+		Keys  map[string]int    `validate:"key:set-random"`
+	}
+
+	newExample := func() *example {
+		obj := &example{
+			Items: make(map[string]string),
+			Keys:  make(map[string]int),
+		}
+
+		for _, key := range []string{"a", "b", "c", "d", "e", "f", "g", "h"} {
+			obj.Items[key] = "value " + key
+			obj.Keys["key"+key] = len(obj.Keys)
+		}
+
+		return obj
+	}
+
+	expected := newExample()
+	if errs := NewValidator().Validate(expected, WithRandomSeed(42)); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	for i := 0; i < 20; i++ {
+		obj := newExample()
+		if errs := NewValidator().Validate(obj, WithRandomSeed(42)); len(errs) != 0 {
+			t.Fatalf("unexpected errors %v", errs)
+		}
+
+		if !reflect.DeepEqual(obj, expected) {
+			t.Fatalf("expected the same values with the same seed, got %v and %v", obj, expected)
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package validator
+
+import (
+	"reflect"
+)
+
+func (v *Validator) validate_set_redact(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	isNil, obj, value := v._getValue(validationData.Value)
+	if isNil {
+		return rtnErrs
+	}
+
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		newValue := constRedacted
+		if expected := v._convertToString(validationData.Expected); expected != "" {
+			newValue = expected
+		}
+
+		if err := _setValue(kind, obj, newValue); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	default:
+		// the other types are cleared
+		obj.Set(reflect.Zero(obj.Type()))
+	}
+
+	return rtnErrs
+}
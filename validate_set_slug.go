@@ -0,0 +1,48 @@
+package validator
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+func (v *Validator) validate_set_slug(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	_, obj, value := v._getValue(validationData.Value)
+	if !obj.CanAddr() {
+		rtnErrs = append(rtnErrs, ErrorInvalidPointer)
+		return rtnErrs
+	}
+
+	kind := reflect.TypeOf(value).Kind()
+	switch kind {
+	case reflect.String:
+		separator := constSlugSeparator
+		maxLength := 0
+
+		// arguments: separator;max length
+		if expected := v._convertToString(validationData.Expected); expected != "" {
+			split := strings.Split(expected, constTagSplitValues)
+			if split[0] != "" {
+				separator = split[0]
+			}
+
+			if len(split) > 1 {
+				var err error
+				if maxLength, err = strconv.Atoi(strings.TrimSpace(split[1])); err != nil {
+					rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, expected))
+					return rtnErrs
+				}
+			}
+		}
+
+		newValue := slug(value.(string), separator, maxLength, true)
+		if err := _setValue(kind, obj, newValue); err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+	}
+
+	return rtnErrs
+}
@@ -0,0 +1,24 @@
+package validator
+
+import (
+	"strings"
+
+	"github.com/joaosoft/errors"
+)
+
+func (v *Validator) validate_severity(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	switch strings.ToLower(v._convertToString(validationData.Expected)) {
+	case constSeverityError:
+		validationData.Severity = errors.LevelError
+	case constSeverityWarning, constSeverityWarn:
+		validationData.Severity = errors.LevelWarn
+	case constSeverityInfo:
+		validationData.Severity = errors.LevelInfo
+	default:
+		rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, validationData.Expected))
+	}
+
+	return rtnErrs
+}
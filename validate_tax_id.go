@@ -0,0 +1,43 @@
+package validator
+
+import (
+	"strings"
+)
+
+func (v *Validator) validate_tax_id(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	// arguments: countries or documents, the value is valid on one of them
+	var handlers []taxIdHandler
+	for _, argument := range strings.Split(v._convertToString(validationData.Expected), constTagSplitValues) {
+		expected, err := v._loadExpectedValue(context, strings.TrimSpace(argument))
+		if err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+
+		name := strings.ToLower(v._convertToString(expected))
+		handler, ok := v.handlersTaxId[name]
+		if !ok {
+			rtnErrs = append(rtnErrs, formatError(ErrorInvalidTagArgument, name))
+			return rtnErrs
+		}
+		handlers = append(handlers, handler)
+	}
+
+	return v._validateIdentifier(validationData, func(value string) error {
+		// the value can be only separators
+		if value = cleanTaxId(value); value == "" {
+			return ErrorInvalidFormat
+		}
+
+		var err error
+		for _, handler := range handlers {
+			if err = handler(value); err == nil {
+				return nil
+			}
+		}
+
+		return err
+	})
+}
@@ -1,8 +1,8 @@
 package validator
 
 import (
-	"net/url"
 	"reflect"
+	"strings"
 )
 
 func (v *Validator) validate_url(context *ValidatorContext, validationData *ValidationData) []error {
@@ -10,11 +10,17 @@ func (v *Validator) validate_url(context *ValidatorContext, validationData *Vali
 
 	_, _, value := v._getValue(validationData.Value)
 
+	// arguments: the allowed schemes
+	var schemes []string
+	if expected := v._convertToString(validationData.Expected); expected != "" {
+		schemes = strings.Split(expected, constTagSplitValues)
+	}
+
 	kind := reflect.TypeOf(value).Kind()
 	switch kind {
 	case reflect.String:
-		if _, err := url.ParseRequestURI(v._convertToString(value)); err != nil {
-			rtnErrs = append(rtnErrs, ErrorInvalidValue)
+		if err := checkUrl(v._convertToString(value), schemes...); err != nil {
+			rtnErrs = append(rtnErrs, err)
 		}
 	}
 
@@ -0,0 +1,5 @@
+package validator
+
+func (v *Validator) validate_url_absolute(context *ValidatorContext, validationData *ValidationData) []error {
+	return v._validateNetwork(validationData, checkUrlAbsolute)
+}
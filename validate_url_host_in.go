@@ -0,0 +1,24 @@
+package validator
+
+import (
+	"strings"
+)
+
+func (v *Validator) validate_url_host_in(context *ValidatorContext, validationData *ValidationData) []error {
+	rtnErrs := make([]error, 0)
+
+	// arguments: hosts, that can be the value of other field
+	var hosts []string
+	for _, argument := range strings.Split(v._convertToString(validationData.Expected), constTagSplitValues) {
+		expected, err := v._loadExpectedValue(context, strings.TrimSpace(argument))
+		if err != nil {
+			rtnErrs = append(rtnErrs, err)
+			return rtnErrs
+		}
+		hosts = append(hosts, v._convertToString(expected))
+	}
+
+	return v._validateNetwork(validationData, func(value string) error {
+		return checkUrlHostIn(value, hosts)
+	})
+}
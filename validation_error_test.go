@@ -0,0 +1,87 @@
+package validator
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+var errValidationCode = errors.New("code error")
+
+func validationErrorCodeHandler(context *ValidatorContext, validationData *ValidationData) error {
+	if validationData.ErrorData.Code == "E_CODE" {
+		return errValidationCode
+	}
+
+	return nil
+}
+
+func TestValidationError(t *testing.T) {
+	tests := []struct {
+		name     string
+		obj      interface{}
+		tag      string
+		expected interface{}
+		code     string
+		message  string
+		wrapped  error
+	}{
+		{
+			name: "message",
+			obj: &struct {
+				Value string `validate:"max=3, error=too long"`
+			}{Value: "abcd"},
+			tag:      "max",
+			expected: "3",
+			message:  "too long",
+		},
+		{
+			name: "code",
+			obj: &struct {
+				Value int `validate:"min=10, error={{E_CODE}}"`
+			}{Value: 5},
+			tag:      "min",
+			expected: "10",
+			code:     "E_CODE",
+			message:  errValidationCode.Error(),
+			wrapped:  errValidationCode,
+		},
+		{
+			name: "second rule",
+			obj: &struct {
+				Value string `validate:"prefix=a, suffix=z, error=wrong suffix"`
+			}{Value: "abc"},
+			tag:      "suffix",
+			expected: "z",
+			message:  "wrong suffix",
+		},
+	}
+
+	validator := NewValidator().SetErrorCodeHandler(validationErrorCodeHandler)
+	for _, test := range tests {
+		errs := validator.Validate(test.obj)
+		if len(errs) != 1 {
+			t.Errorf("%s: expected 1 error, got %v", test.name, errs)
+			continue
+		}
+
+		var validationErr *ValidationError
+		if !errors.As(errs[0], &validationErr) {
+			t.Errorf("%s: expected a validation error, got %T", test.name, errs[0])
+			continue
+		}
+
+		if validationErr.Tag != test.tag || !reflect.DeepEqual(validationErr.Expected, test.expected) ||
+			validationErr.Code != test.code || validationErr.Error() != test.message {
+			t.Errorf("%s: unexpected validation error %+v", test.name, validationErr)
+		}
+
+		if validationErr.Original == nil || !errors.Is(errs[0], validationErr.Original) {
+			t.Errorf("%s: the original error is not wrapped", test.name)
+		}
+
+		if test.wrapped != nil && !errors.Is(errs[0], test.wrapped) {
+			t.Errorf("%s: the error %s is not wrapped", test.name, test.wrapped)
+		}
+	}
+}
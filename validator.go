@@ -1,15 +1,21 @@
 package validator
 
 import (
+	"fmt"
+	"reflect"
+	"strings"
+
 	"github.com/joaosoft/logger"
 )
 
 func NewValidator() *Validator {
 	v := &Validator{
-		tag:       constDefaultValidationTag,
-		callbacks: make(map[string]callbackHandler),
-		sanitize:  make([]string, 0),
-		logger:    logger.NewLogDefault(constDefaultLogTag, logger.LevelInfo),
+		tag:        constDefaultValidationTag,
+		callbacks:  make(map[string]callbackHandler),
+		errorCodes: make(map[string]string),
+		hmacKeys:   make(map[string][]byte),
+		sanitize:   make([]string, 0),
+		logger:     logger.NewLogDefault(constDefaultLogTag, logger.LevelInfo),
 	}
 
 	v.init()
@@ -24,10 +30,18 @@ func (v *Validator) newActiveHandlers() map[string]empty {
 		handlers[key] = empty{}
 	}
 
+	for key, _ := range v.handlersMask {
+		handlers[key] = empty{}
+	}
+
 	for key, _ := range v.handlersMiddle {
 		handlers[key] = empty{}
 	}
 
+	for key, _ := range v.handlersMutation {
+		handlers[key] = empty{}
+	}
+
 	for key, _ := range v.handlersAfter {
 		handlers[key] = empty{}
 	}
@@ -49,6 +63,39 @@ func (v *Validator) AddMiddle(name string, handler middleTagHandler) *Validator
 	return v
 }
 
+func (v *Validator) AddMutation(name string, handler mutationTagHandler) *Validator {
+	v.handlersMutation[name] = handler
+	v.activeHandlers[name] = empty{}
+
+	return v
+}
+
+func (v *Validator) AddMask(name string, handler mutationTagHandler) *Validator {
+	v.handlersMask[name] = handler
+	v.activeHandlers[name] = empty{}
+
+	return v
+}
+
+func (v *Validator) AddTaxId(name string, handler taxIdHandler) *Validator {
+	v.handlersTaxId[strings.ToLower(name)] = handler
+
+	return v
+}
+
+func (v *Validator) AddHasher(name string, hasher Hasher) *Validator {
+	tag := fmt.Sprintf("%s-%s", constTagSet, name)
+	v.sensitiveTags[tag] = empty{}
+
+	return v.AddMutation(tag, v.newHashHandler(hasher))
+}
+
+func (v *Validator) AddHmacKey(id string, key []byte) *Validator {
+	v.hmacKeys[id] = key
+
+	return v
+}
+
 func (v *Validator) AddAfter(name string, handler afterTagHandler) *Validator {
 	v.handlersAfter[name] = handler
 	v.activeHandlers[name] = empty{}
@@ -62,24 +109,103 @@ func (v *Validator) SetErrorCodeHandler(handler errorCodeHandler) *Validator {
 	return v
 }
 
+func (v *Validator) SetDefaultErrorCode(tag string, code string) *Validator {
+	v.errorCodes[tag] = code
+
+	return v
+}
+
 func (v *Validator) SetValidateAll(canValidateAll bool) *Validator {
 	v.canValidateAll = canValidateAll
 
 	return v
 }
 
+func (v *Validator) SetMutationPhase(enabled bool) *Validator {
+	v.mutationPhase = enabled
+
+	return v
+}
+
+func (v *Validator) SetRandomSeed(seed int64) *Validator {
+	v.randomSeed = &seed
+
+	return v
+}
+
 func (v *Validator) SetTag(tag string) *Validator {
 	v.tag = tag
 
 	return v
 }
 
+// SetPasswordSettings sets the default password policy, the nil settings are ignored
 func (v *Validator) SetPasswordSettings(settings *PasswordSettings) *Validator {
-	v.password.settings = settings
+	return v.RegisterPasswordPolicy(constPasswordPolicyDefault, settings)
+}
+
+// RegisterPasswordPolicy registers a password policy by name, the nil settings are ignored
+func (v *Validator) RegisterPasswordPolicy(name string, settings *PasswordSettings) *Validator {
+	if settings == nil {
+		return v
+	}
+
+	v.password.policies[name] = settings
 
 	return v
 }
 
+// LoadPasswordPolicies registers the password policies of a json or yaml file, by name
+func (v *Validator) LoadPasswordPolicies(path string) error {
+	policies, err := v.password.load(path)
+	if err != nil {
+		return err
+	}
+
+	for name, settings := range policies {
+		v.RegisterPasswordPolicy(name, settings)
+	}
+
+	return nil
+}
+
+// SetPasswordBlackList replaces the default black list on the policies that use it
+func (v *Validator) SetPasswordBlackList(blackList BlackListProvider) *Validator {
+	for _, settings := range v.password.policies {
+		if settings.BlackList == v.password.blackList {
+			settings.BlackList = blackList
+		}
+	}
+	v.password.blackList = blackList
+
+	return v
+}
+
+// SetBreachProvider sets the provider of the breached password hashes, used with the tag password=breach
+func (v *Validator) SetBreachProvider(provider BreachProvider) *Validator {
+	v.password.breach = provider
+
+	return v
+}
+
+// IsPasswordBreached returns how many times the password was found on data breaches
+func (v *Validator) IsPasswordBreached(value string) (int, error) {
+	return v.password.breached(value)
+}
+
+func (v *Validator) EvaluatePassword(value string, policy string, userValues ...string) (*PasswordStrength, error) {
+	if policy == "" {
+		policy = constPasswordPolicyDefault
+	}
+
+	settings, ok := v.password.policies[policy]
+	if !ok {
+		return nil, formatError(ErrorInvalidTagArgument, policy)
+	}
+
+	return settings.Evaluate(value, userValues...), nil
+}
+
 func (v *Validator) SetSanitize(sanitize []string) *Validator {
 	v.sanitize = sanitize
 
@@ -92,6 +218,64 @@ func (v *Validator) AddCallback(name string, callback callbackHandler) *Validato
 	return v
 }
 
-func (v *Validator) Validate(obj interface{}, args ...*argument) []error {
-	return NewValidatorHandler(v, args...).handleValidation(obj)
+func (v *Validator) Validate(obj interface{}, options ...Option) []error {
+	return NewValidatorHandler(v, options...).handleValidation(obj)
+}
+
+func (v *Validator) ValidatePartial(obj interface{}, fields ...string) []error {
+	return v.Validate(obj, WithFields(fields...))
+}
+
+func (v *Validator) ValidateExcept(obj interface{}, fields ...string) []error {
+	return v.Validate(obj, WithoutFields(fields...))
+}
+
+func (v *Validator) ValidateWithResult(obj interface{}, options ...Option) *Result {
+	context := NewValidatorHandler(v, options...)
+	context.trackChanges = true
+	errs := context.handleValidation(obj)
+
+	return &Result{
+		Errors:   errs,
+		Warnings: context.warnings,
+		Changes:  context.changes,
+	}
+}
+
+func (v *Validator) Normalize(obj interface{}, options ...Option) ([]*Change, []error) {
+	context := NewValidatorHandler(v, options...)
+	context.trackChanges = true
+	context.phase = phaseMutation
+	errs := context.handleValidation(obj)
+
+	return context.changes, errs
+}
+
+// Redact returns a copy of the object with the mask tags applied, without changing the original
+func (v *Validator) Redact(obj interface{}, options ...Option) (interface{}, []error) {
+	context := NewValidatorHandler(v, options...)
+	context.phase = phaseRedact
+
+	redacted := _cloneToPointer(obj)
+	errs := context.handleValidation(redacted)
+
+	if value := reflect.ValueOf(obj); value.IsValid() && value.Kind() != reflect.Ptr {
+		redacted = reflect.ValueOf(redacted).Elem().Interface()
+	}
+
+	return redacted, errs
+}
+
+// ValidateAndNormalizeWith validates a copy of the values, returning the copy with the set tags applied.
+// The pointers are validated and changed in place, like on Validate.
+func ValidateAndNormalizeWith[T any](v *Validator, obj T, options ...Option) (T, []error) {
+	value := reflect.ValueOf(obj)
+	if !value.IsValid() || value.Kind() == reflect.Ptr {
+		return obj, v.Validate(obj, options...)
+	}
+
+	normalized := _cloneToPointer(obj)
+	errs := v.Validate(normalized, options...)
+
+	return reflect.ValueOf(normalized).Elem().Interface().(T), errs
 }
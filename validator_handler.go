@@ -2,8 +2,18 @@ package validator
 
 import (
 	"fmt"
+	"math/rand"
 	"reflect"
+	"regexp"
 	"strings"
+	"time"
+
+	"github.com/joaosoft/errors"
+)
+
+var (
+	errorcodereg     = regexp.MustCompile(constRegexForErrorCode)
+	errorcodecallreg = regexp.MustCompile(constRegexForErrorCodeCall)
 )
 
 func (v *Validator) newDefaultValues() defaultValues {
@@ -14,24 +24,44 @@ func (v *Validator) newDefaultValues() defaultValues {
 	}
 }
 
-func NewValidatorHandler(validator *Validator, args ...*argument) *ValidatorContext {
+func NewValidatorHandler(validator *Validator, options ...Option) *ValidatorContext {
 	context := &ValidatorContext{
 		validator: validator,
 		values:    validator.newDefaultValues(),
+		groups:    map[string]empty{constDefaultGroup: {}},
 	}
 
-	for _, arg := range args {
-		context.values[constTagArg][arg.Id] = &data{
-			value: reflect.ValueOf(arg.Value),
-			typ: reflect.StructField{
-				Type: reflect.TypeOf(arg.Value),
-			},
-		}
+	for _, option := range options {
+		option.apply(context)
 	}
 
 	return context
 }
 
+// getRandom returns the random generator of the validation, seeded by the call, by the validator or by the time
+func (vc *ValidatorContext) getRandom() *rand.Rand {
+	if vc.random == nil {
+		seed := time.Now().UnixNano()
+
+		if vc.randomSeed != nil {
+			seed = *vc.randomSeed
+		} else if vc.validator.randomSeed != nil {
+			seed = *vc.validator.randomSeed
+		}
+
+		vc.random = rand.New(rand.NewSource(seed))
+	}
+
+	return vc.random
+}
+
+func (vc *ValidatorContext) conditionContext() *ValidatorContext {
+	context := *vc
+	context.groups = nil
+
+	return &context
+}
+
 func (vc *ValidatorContext) GetValue(tag string, id string) (*data, bool) {
 	if values, ok := vc.values[tag]; ok {
 		if value, ok := values[id]; ok {
@@ -50,11 +80,52 @@ func (vc *ValidatorContext) SetValue(tag string, id string, value *data) bool {
 }
 
 func (vc *ValidatorContext) handleValidation(value interface{}) []error {
+	if vc.dryRun {
+		value = _cloneToPointer(value)
+	}
+
+	if len(vc.groupSequence) == 0 {
+		return vc.handleGroupValidation(value)
+	}
+
+	// stops on the first group with errors
+	for _, group := range vc.groupSequence {
+		vc.groups = map[string]empty{group: {}}
+
+		if errs := vc.handleGroupValidation(value); len(errs) > 0 {
+			return errs
+		}
+	}
+
+	return make([]error, 0)
+}
+
+func (vc *ValidatorContext) handleGroupValidation(value interface{}) []error {
+	if !vc.validator.mutationPhase || vc.phase != phaseAll {
+		return vc.handlePhaseValidation(value)
+	}
+
+	// all the mutations run before the validations
+	defer func() {
+		vc.phase = phaseAll
+	}()
+
+	vc.phase = phaseMutation
+	errs := vc.handlePhaseValidation(value)
+	if len(errs) > 0 && !vc.validator.canValidateAll {
+		return errs
+	}
+
+	vc.phase = phaseValidation
+	return append(errs, vc.handlePhaseValidation(value)...)
+}
+
+func (vc *ValidatorContext) handlePhaseValidation(value interface{}) []error {
 	var err error
 	errs := make([]error, 0)
 
 	// execute
-	if err = vc.do(reflect.ValueOf(value), &errs); err != nil {
+	if err = vc.do(reflect.ValueOf(value), fieldPath{}, &errs); err != nil {
 		return []error{err}
 	}
 
@@ -175,7 +246,7 @@ func (vc *ValidatorContext) load(value reflect.Value, errs *[]error) (err error)
 		}
 
 	case reflect.Map:
-		for _, key := range value.MapKeys() {
+		for _, key := range sortedMapKeys(value) {
 			nextValue := value.MapIndex(key)
 
 			if !nextValue.CanInterface() {
@@ -196,7 +267,10 @@ func (vc *ValidatorContext) load(value reflect.Value, errs *[]error) (err error)
 	return nil
 }
 
-func (vc *ValidatorContext) do(value reflect.Value, errs *[]error) (err error) {
+func (vc *ValidatorContext) do(value reflect.Value, path fieldPath, errs *[]error) (err error) {
+	value, writeBack := vc.writable(value)
+	defer writeBack()
+
 	var types reflect.Type
 	types, value, err = vc._getValue(value)
 	if err != nil {
@@ -207,27 +281,30 @@ func (vc *ValidatorContext) do(value reflect.Value, errs *[]error) (err error) {
 	case reflect.Struct:
 
 		// load id's
-		if err := vc.load(reflect.ValueOf(value), errs); err != nil {
+		if err := vc.load(value, errs); err != nil {
 			return err
 		}
 
 		for i := 0; i < types.NumField(); i++ {
 			nextValue := value.Field(i)
 			nextType := types.Field(i)
+			nextPath := path.field(nextType)
 
 			if !nextValue.CanInterface() {
 				continue
 			}
 
-			if err := vc.doValidate(nextValue, nextType, errs); err != nil {
-				return err
-			}
+			if vc.canValidateField(nextPath, nextValue) {
+				if err := vc.doValidate(nextValue, nextType, nextPath, errs); err != nil {
+					return err
+				}
 
-			if len(*errs) > 0 && !vc.validator.canValidateAll {
-				return nil
+				if len(*errs) > 0 && !vc.validator.canValidateAll {
+					return nil
+				}
 			}
 
-			if err := vc.do(nextValue, errs); err != nil {
+			if err := vc.do(nextValue, nextPath, errs); err != nil {
 				return err
 			}
 
@@ -244,7 +321,7 @@ func (vc *ValidatorContext) do(value reflect.Value, errs *[]error) (err error) {
 				continue
 			}
 
-			if err := vc.do(nextValue, errs); err != nil {
+			if err := vc.do(nextValue, path.index(i), errs); err != nil {
 				return err
 			}
 
@@ -254,24 +331,31 @@ func (vc *ValidatorContext) do(value reflect.Value, errs *[]error) (err error) {
 		}
 
 	case reflect.Map:
-		for _, key := range value.MapKeys() {
-			nextValue := value.MapIndex(key)
-
-			if !nextValue.CanInterface() {
+		for _, key := range sortedMapKeys(value) {
+			if !key.CanInterface() || !value.MapIndex(key).CanInterface() {
 				continue
 			}
 
-			if err := vc.do(key, errs); err != nil {
+			nextKey, writeBackKey := vc.writableMapKey(value, key)
+			err := vc.do(nextKey, path.index(key.Interface()), errs)
+			if err != nil {
 				return err
 			}
 
+			if key, err = writeBackKey(); err != nil {
+				*errs = append(*errs, err)
+			}
+
 			if len(*errs) > 0 && !vc.validator.canValidateAll {
 				return nil
 			}
 
-			if err := vc.do(nextValue, errs); err != nil {
+			nextValue, writeBackValue := vc.writableMapIndex(value, key)
+			err = vc.do(nextValue, path.index(key.Interface()), errs)
+			if err != nil {
 				return err
 			}
+			writeBackValue()
 
 			if len(*errs) > 0 && !vc.validator.canValidateAll {
 				return nil
@@ -284,7 +368,7 @@ func (vc *ValidatorContext) do(value reflect.Value, errs *[]error) (err error) {
 	return nil
 }
 
-func (vc *ValidatorContext) doValidate(value reflect.Value, typ reflect.StructField, errs *[]error) error {
+func (vc *ValidatorContext) doValidate(value reflect.Value, typ reflect.StructField, path fieldPath, errs *[]error) error {
 
 	tag, exists := typ.Tag.Lookup(vc.validator.tag)
 	if !exists {
@@ -293,7 +377,82 @@ func (vc *ValidatorContext) doValidate(value reflect.Value, typ reflect.StructFi
 
 	validations := strings.Split(tag, ",")
 
-	return vc.execute(typ, value, validations, errs)
+	return vc.execute(typ, value, path, validations, errs)
+}
+
+func (vc *ValidatorContext) isMutation(tag string) bool {
+	_, ok := vc.validator.handlersMutation[tag]
+	return ok
+}
+
+func (vc *ValidatorContext) isMask(tag string) bool {
+	_, ok := vc.validator.handlersMask[tag]
+	return ok
+}
+
+func (vc *ValidatorContext) isSensitive(tag string) bool {
+	_, ok := vc.validator.sensitiveTags[tag]
+	return ok || vc.isMask(tag)
+}
+
+func (vc *ValidatorContext) isRule(tag string) bool {
+	_, ok := vc.validator.handlersMiddle[tag]
+	return ok || vc.isMutation(tag) || vc.isMask(tag)
+}
+
+func (vc *ValidatorContext) isPhaseTag(tag string) bool {
+	// the masks only run when redacting
+	if vc.isMask(tag) {
+		return vc.phase == phaseRedact
+	}
+
+	switch vc.phase {
+	case phaseMutation:
+		if _, ok := vc.validator.handlersMiddle[tag]; ok {
+			return false
+		}
+	case phaseValidation:
+		return !vc.isMutation(tag)
+	case phaseRedact:
+		return !vc.isRule(tag)
+	}
+
+	return true
+}
+
+func (vc *ValidatorContext) addChange(path fieldPath, tag string, before interface{}, value reflect.Value) {
+	after := _clone(value).Interface()
+	if reflect.DeepEqual(before, after) {
+		return
+	}
+
+	// the hashes and the masks can not leak the value that they hide
+	if vc.isSensitive(tag) {
+		before = nil
+	}
+
+	vc.changes = append(vc.changes, &Change{
+		Path: path.name,
+		Tag:  tag,
+		Old:  before,
+		New:  after,
+	})
+}
+
+func (vc *ValidatorContext) canValidateField(path fieldPath, value reflect.Value) bool {
+	if len(vc.fields) > 0 && !path.in(vc.fields) {
+		return false
+	}
+
+	if len(vc.exceptFields) > 0 && path.in(vc.exceptFields) {
+		return false
+	}
+
+	if vc.nonZero && value.IsZero() {
+		return false
+	}
+
+	return true
 }
 
 func (vc *ValidatorContext) getFieldId(validations []string) string {
@@ -309,11 +468,91 @@ func (vc *ValidatorContext) getFieldId(validations []string) string {
 	return ""
 }
 
-func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value, validations []string, errs *[]error) error {
+func (vc *ValidatorContext) getFieldGroups(validations []string) []string {
+	for _, validation := range validations {
+		options := strings.SplitN(validation, "=", 2)
+		tag := strings.TrimSpace(options[0])
+
+		if tag == constTagGroups && len(options) > 1 {
+			return vc.getGroups(options[1])
+		}
+	}
+
+	return nil
+}
+
+func (vc *ValidatorContext) getGroups(value string) []string {
+	groups := make([]string, 0)
+
+	for _, group := range strings.Split(value, constTagSplitValues) {
+		if group = strings.TrimSpace(group); group != "" {
+			groups = append(groups, group)
+		}
+	}
+
+	return groups
+}
+
+func (vc *ValidatorContext) isGroupActive(groups []string) bool {
+	if vc.groups == nil {
+		return true
+	}
+
+	if len(groups) == 0 {
+		groups = []string{constDefaultGroup}
+	}
+
+	for _, group := range groups {
+		if _, ok := vc.groups[group]; ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (vc *ValidatorContext) getErrorCode(validation string) (string, string) {
+	validation = strings.TrimSpace(validation)
+
+	// tag(arguments):code, available on every tag
+	if match := errorcodecallreg.FindStringSubmatch(validation); match != nil {
+		if match[2] == "" {
+			return match[1], match[3]
+		}
+		return match[1] + "=" + match[2], match[3]
+	}
+
+	index := strings.LastIndex(validation, constTagErrorCodeSeparator)
+	if index == -1 {
+		return validation, ""
+	}
+
+	options := strings.SplitN(validation[:index], "=", 2)
+	tag := strings.TrimSpace(options[0])
+	if split := strings.Split(tag, ":"); len(split) > 1 {
+		tag = split[len(split)-1]
+	}
+
+	// tag=arguments|code, only on the tags whose arguments can not have the separator
+	if len(options) == 2 && !errorCodeSeparatorTags[tag] {
+		return validation, ""
+	}
+
+	code := strings.TrimSpace(validation[index+len(constTagErrorCodeSeparator):])
+	if !errorcodereg.MatchString(code) {
+		return validation, ""
+	}
+
+	return validation[:index], code
+}
+
+func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value, path fieldPath, validations []string, errs *[]error) error {
 	var err error
 	var itErrs []error
+	var itRules []*errorRule
 	var replacedErrors = make(map[error]bool)
 	skipValidation := false
+	field := value
 	onlyHandleNextErrorTag := false
 
 	defer func() {
@@ -323,19 +562,48 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 	baseData := &baseData{
 		Id:        vc.getFieldId(validations),
 		Arguments: make([]interface{}, 0),
+		Severity:  errors.LevelError,
+		Groups:    vc.getFieldGroups(validations),
+		Path:      path.name,
+		Metadata:  make(map[string]interface{}),
 	}
 
 	for _, validation := range validations {
 		var name string
 		var tag string
 		var prefix string
+		var code string
 
+		validation, code = vc.getErrorCode(validation)
 		options := strings.SplitN(validation, "=", 2)
 		tag = strings.TrimSpace(options[0])
 
-		if split := strings.SplitN(tag, ":", 2); len(split) > 1 {
-			prefix = split[0]
-			tag = split[1]
+		severity := baseData.Severity
+		groups := baseData.Groups
+		if split := strings.Split(tag, ":"); len(split) > 1 {
+			tag = split[len(split)-1]
+
+			for _, item := range split[:len(split)-1] {
+				switch item {
+				case constPrefixTagWarn:
+					severity = errors.LevelWarn
+				case constPrefixTagKey, constPrefixTagItem:
+					if prefix != "" {
+						return formatError(ErrorInvalidTagPrefix, item, tag)
+					}
+					prefix = item
+				default:
+					// the groups have an explicit prefix, so a typo is not taken as a group
+					if !strings.HasPrefix(item, constPrefixTagGroup) {
+						return formatError(ErrorInvalidTagPrefix, item, tag)
+					}
+					groups = vc.getGroups(item[len(constPrefixTagGroup):])
+				}
+			}
+		}
+
+		if code == "" {
+			code = vc.validator.errorCodes[tag]
 		}
 
 		if onlyHandleNextErrorTag && !vc.validator.canValidateAll && tag != constTagError {
@@ -346,6 +614,14 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 			return ErrorInvalidTag.Format(tag)
 		}
 
+		if !vc.isPhaseTag(tag) {
+			continue
+		}
+
+		if vc.isRule(tag) && !vc.isGroupActive(groups) {
+			continue
+		}
+
 		var expected interface{}
 		if len(options) > 1 {
 			expected = strings.TrimSpace(options[1])
@@ -367,6 +643,12 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 			}
 		}
 
+		var before interface{}
+		trackChange := vc.trackChanges && (vc.isMutation(tag) || vc.isMask(tag))
+		if trackChange {
+			before = _clone(field).Interface()
+		}
+
 		// execute validations
 		switch prefix {
 		case constPrefixTagKey, constPrefixTagItem:
@@ -393,33 +675,41 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 						continue
 					}
 
+					nextValue, writeBack := vc.writable(nextValue)
+
 					validationData := ValidationData{
 						baseData:       baseData,
 						Name:           name,
 						Field:          typ.Name,
 						Parent:         value,
 						Value:          nextValue,
+						Tag:            tag,
 						Expected:       expected,
 						Errors:         &itErrs,
 						ErrorsReplaced: replacedErrors,
+						errorCode:      code,
+						severity:       severity,
+						errorRules:     &itRules,
 					}
 
 					err = vc.executeHandlers(tag, &validationData, &itErrs)
+					writeBack()
 				}
 			case reflect.Map:
-				for _, key := range value.MapKeys() {
+				for _, key := range sortedMapKeys(value) {
+
+					if !key.CanInterface() {
+						continue
+					}
 
 					var nextValue reflect.Value
+					var writeBack func() (reflect.Value, error)
 
 					switch prefix {
 					case constPrefixTagKey:
-						nextValue = key
+						nextValue, writeBack = vc.writableMapKey(value, key)
 					case constPrefixTagItem:
-						nextValue = value.MapIndex(key)
-					}
-
-					if !key.CanInterface() {
-						continue
+						nextValue, writeBack = vc.writableMapIndex(value, key)
 					}
 
 					validationData := ValidationData{
@@ -428,12 +718,19 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 						Field:          typ.Name,
 						Parent:         value,
 						Value:          nextValue,
+						Tag:            tag,
 						Expected:       expected,
 						Errors:         &itErrs,
 						ErrorsReplaced: replacedErrors,
+						errorCode:      code,
+						severity:       severity,
+						errorRules:     &itRules,
 					}
 
 					err = vc.executeHandlers(tag, &validationData, &itErrs)
+					if _, keyErr := writeBack(); keyErr != nil {
+						itErrs = append(itErrs, keyErr)
+					}
 				}
 			case reflect.Struct:
 				for i := 0; i < types.NumField(); i++ {
@@ -443,18 +740,25 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 						continue
 					}
 
+					nextValue, writeBack := vc.writable(nextValue)
+
 					validationData := ValidationData{
 						baseData:       baseData,
 						Name:           name,
 						Field:          typ.Name,
 						Parent:         value,
 						Value:          nextValue,
+						Tag:            tag,
 						Expected:       expected,
 						Errors:         &itErrs,
 						ErrorsReplaced: replacedErrors,
+						errorCode:      code,
+						severity:       severity,
+						errorRules:     &itRules,
 					}
 
 					err = vc.executeHandlers(tag, &validationData, &itErrs)
+					writeBack()
 				}
 			}
 
@@ -463,18 +767,29 @@ func (vc *ValidatorContext) execute(typ reflect.StructField, value reflect.Value
 				return ErrorInvalidTagPrefix.Format(prefix, tag)
 			}
 
+			nextValue, writeBack := vc.writable(value)
+
 			validationData := ValidationData{
 				baseData:       baseData,
 				Name:           name,
 				Field:          typ.Name,
 				Parent:         value,
-				Value:          value,
+				Value:          nextValue,
+				Tag:            tag,
 				Expected:       expected,
 				Errors:         &itErrs,
 				ErrorsReplaced: replacedErrors,
+				errorCode:      code,
+				severity:       severity,
+				errorRules:     &itRules,
 			}
 
 			err = vc.executeHandlers(tag, &validationData, &itErrs)
+			writeBack()
+		}
+
+		if trackChange {
+			vc.addChange(path, tag, before, field)
 		}
 
 		if onlyHandleNextErrorTag && !vc.validator.canValidateAll && tag == constTagError {
@@ -520,21 +835,81 @@ func (vc *ValidatorContext) executeHandlers(tag string, validationData *Validati
 			if rtnErrs[0] == ErrorSkipValidation {
 				return rtnErrs[0]
 			}
-			*errs = append(*errs, rtnErrs...)
+			vc.appendErrors(validationData, errs, rtnErrs)
+		}
+	}
+
+	if _, ok := vc.validator.handlersMutation[tag]; ok {
+		if rtnErrs := vc.validator.handlersMutation[tag](vc, validationData); rtnErrs != nil && len(rtnErrs) > 0 {
+			vc.appendRuleErrors(validationData, errs, rtnErrs)
+		}
+	}
+
+	if _, ok := vc.validator.handlersMask[tag]; ok {
+		if rtnErrs := vc.validator.handlersMask[tag](vc, validationData); rtnErrs != nil && len(rtnErrs) > 0 {
+			vc.appendRuleErrors(validationData, errs, rtnErrs)
 		}
 	}
 
 	if _, ok := vc.validator.handlersMiddle[tag]; ok {
 		if rtnErrs := vc.validator.handlersMiddle[tag](vc, validationData); rtnErrs != nil && len(rtnErrs) > 0 {
-			*errs = append(*errs, rtnErrs...)
+			vc.appendRuleErrors(validationData, errs, rtnErrs)
 		}
 	}
 
 	if _, ok := vc.validator.handlersAfter[tag]; ok {
 		if rtnErrs := vc.validator.handlersAfter[tag](vc, validationData); rtnErrs != nil && len(rtnErrs) > 0 {
-			*errs = append(*errs, rtnErrs...)
+			vc.appendErrors(validationData, errs, rtnErrs)
 		}
 	}
 
 	return err
 }
+
+func (vc *ValidatorContext) appendRuleErrors(validationData *ValidationData, errs *[]error, rtnErrs []error) {
+	if validationData.errorCode != "" || validationData.severity != errors.LevelError {
+		rtnErrs = vc.validator.handleErrorCode(vc, validationData, rtnErrs)
+	}
+
+	// warnings are reported apart and do not fail the validation
+	if validationData.severity > errors.LevelError {
+		vc.warnings = append(vc.warnings, rtnErrs...)
+	} else {
+		vc.appendErrors(validationData, errs, rtnErrs)
+	}
+}
+
+func (vc *ValidatorContext) appendErrors(validationData *ValidationData, errs *[]error, rtnErrs []error) {
+	*errs = append(*errs, rtnErrs...)
+
+	if validationData.errorRules == nil {
+		return
+	}
+
+	// keep the failed rules aligned with the errors
+	rules := *validationData.errorRules
+	if size := len(*errs) - len(rtnErrs); len(rules) > size {
+		rules = rules[:size]
+	} else {
+		for len(rules) < size {
+			rules = append(rules, nil)
+		}
+	}
+
+	for range rtnErrs {
+		rules = append(rules, &errorRule{
+			tag:      validationData.Tag,
+			expected: validationData.Expected,
+		})
+	}
+
+	*validationData.errorRules = rules
+}
+
+func (vd *ValidationData) errorRule(index int) *errorRule {
+	if vd.errorRules == nil || index >= len(*vd.errorRules) {
+		return nil
+	}
+
+	return (*vd.errorRules)[index]
+}
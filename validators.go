@@ -7,8 +7,9 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
-	"time"
 	"unicode"
+
+	uuid "github.com/satori/go.uuid"
 )
 
 func (v *Validator) _convertToString(value interface{}) string {
@@ -61,8 +62,7 @@ func (v *Validator) _loadExpectedValue(context *ValidatorContext, expected inter
 	return expected, nil
 }
 
-func (v *Validator) _random(strValue string) string {
-	rand.Seed(time.Now().UnixNano())
+func (v *Validator) _random(random *rand.Rand, strValue string) string {
 	alphabetLowerChars := []rune(constAlphanumericLowerAlphabet)
 	alphabetUpperChars := []rune(constAlphanumericUpperAlphabet)
 	alphabetNumbers := []rune(constNumericAlphabet)
@@ -85,13 +85,87 @@ func (v *Validator) _random(strValue string) string {
 				alphabet = alphabetSpecial
 			}
 
-			newValue[i] = alphabet[rand.Intn(len(alphabet))]
+			newValue[i] = alphabet[random.Intn(len(alphabet))]
+		}
+	}
+
+	return string(newValue)
+}
+
+func (v *Validator) _randomWithMode(random *rand.Rand, mode string, strValue string) string {
+	switch mode {
+	case constRandomModeEmail:
+		return v._randomEmail(random, strValue)
+	case constRandomModePhone:
+		return v._randomPhone(random, strValue)
+	case constRandomModeUUID:
+		return v._randomUUID(random, strValue)
+	default:
+		return v._random(random, strValue)
+	}
+}
+
+// _randomEmail keeps the separators and the top level domain
+func (v *Validator) _randomEmail(random *rand.Rand, strValue string) string {
+	newValue := []rune(strValue)
+	end := len(newValue)
+	if index := strings.LastIndex(strValue, "."); index > strings.LastIndex(strValue, "@") {
+		end = len([]rune(strValue[:index]))
+	}
+
+	for i := 0; i < end; i++ {
+		newValue[i] = v._randomChar(random, newValue[i], constAsciiLowerAlphabet)
+	}
+
+	return string(newValue)
+}
+
+// _randomPhone keeps the separators and the international prefix, when it is apart from the number
+func (v *Validator) _randomPhone(random *rand.Rand, strValue string) string {
+	newValue := []rune(strValue)
+	start := 0
+
+	if strings.HasPrefix(strValue, "+") {
+		if index := strings.IndexFunc(strValue[1:], func(r rune) bool { return !unicode.IsDigit(r) }); index > -1 {
+			start = index + 1
 		}
 	}
 
+	for i := start; i < len(newValue); i++ {
+		newValue[i] = v._randomChar(random, newValue[i], constAsciiLowerAlphabet)
+	}
+
 	return string(newValue)
 }
 
+// _randomUUID keeps the uuid shape, as a version 4 uuid
+func (v *Validator) _randomUUID(random *rand.Rand, strValue string) string {
+	var bytes [16]byte
+	random.Read(bytes[:])
+	bytes[6] = (bytes[6] & 0x0f) | 0x40
+	bytes[8] = (bytes[8] & 0x3f) | 0x80
+
+	newValue := uuid.UUID(bytes).String()
+	if strValue == strings.ToUpper(strValue) {
+		newValue = strings.ToUpper(newValue)
+	}
+
+	return newValue
+}
+
+func (v *Validator) _randomChar(random *rand.Rand, char rune, letters string) rune {
+	switch {
+	case unicode.IsDigit(char):
+		return rune(constNumericAlphabet[random.Intn(len(constNumericAlphabet))])
+	case unicode.IsUpper(char):
+		return unicode.ToUpper(rune(letters[random.Intn(len(letters))]))
+	case unicode.IsLetter(char):
+		return rune(letters[random.Intn(len(letters))])
+	default:
+		return char
+	}
+}
+
 func _setValue(kind reflect.Kind, obj reflect.Value, newValue interface{}) (err error) {
 	switch value := newValue.(type) {
 	case string:
@@ -102,6 +176,12 @@ func _setValue(kind reflect.Kind, obj reflect.Value, newValue interface{}) (err
 				return err
 			}
 			obj.SetInt(int64(v))
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			var v uint64
+			if v, err = strconv.ParseUint(value, 10, 64); err != nil {
+				return err
+			}
+			obj.SetUint(v)
 		case reflect.Float32, reflect.Float64:
 			var v float64
 			if v, err = strconv.ParseFloat(value, 64); err != nil {
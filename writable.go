@@ -0,0 +1,66 @@
+package validator
+
+import "reflect"
+
+// writable returns a copy of the value held by an interface that can be changed by the set tags,
+// with the function to write it back when it was changed
+func (vc *ValidatorContext) writable(value reflect.Value) (reflect.Value, func() reflect.Value) {
+	if value.Kind() != reflect.Interface || value.IsNil() || !value.CanSet() || value.Elem().Kind() == reflect.Ptr {
+		return value, func() reflect.Value { return value }
+	}
+
+	newValue := reflect.New(value.Elem().Type()).Elem()
+	newValue.Set(value.Elem())
+
+	return newValue, func() reflect.Value {
+		if !reflect.DeepEqual(newValue.Interface(), value.Elem().Interface()) {
+			value.Set(newValue)
+		}
+		return value
+	}
+}
+
+// writableMapIndex returns a copy of the map item, since it is not addressable,
+// with the function to write it back when it was changed
+func (vc *ValidatorContext) writableMapIndex(m reflect.Value, key reflect.Value) (reflect.Value, func() (reflect.Value, error)) {
+	original := m.MapIndex(key)
+	item := reflect.New(m.Type().Elem()).Elem()
+	item.Set(original)
+
+	nextValue, writeBack := vc.writable(item)
+
+	return nextValue, func() (reflect.Value, error) {
+		writeBack()
+
+		// the maps are only changed by the set tags, a validation can run on a shared map
+		if !reflect.DeepEqual(item.Interface(), original.Interface()) {
+			m.SetMapIndex(key, item)
+		}
+		return item, nil
+	}
+}
+
+// writableMapKey returns a copy of the map key with the function to move the item to the changed key,
+// that fails when the changed key already exists on the map
+func (vc *ValidatorContext) writableMapKey(m reflect.Value, key reflect.Value) (reflect.Value, func() (reflect.Value, error)) {
+	newKey := reflect.New(m.Type().Key()).Elem()
+	newKey.Set(key)
+
+	nextValue, writeBack := vc.writable(newKey)
+
+	return nextValue, func() (reflect.Value, error) {
+		writeBack()
+		if newKey.Interface() == key.Interface() {
+			return key, nil
+		}
+
+		if m.MapIndex(newKey).IsValid() {
+			return key, formatError(ErrorDuplicatedKey, newKey.Interface())
+		}
+
+		item := m.MapIndex(key)
+		m.SetMapIndex(key, reflect.Value{})
+		m.SetMapIndex(newKey, item)
+		return newKey, nil
+	}
+}
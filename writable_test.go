@@ -0,0 +1,74 @@
+package validator
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestMapWriteBack(t *testing.T) {
+	type example struct {
+		Items  map[string]string      `validate:"item:set-trim"`
+		Keys   map[string]int         `validate:"key:set-upper"`
+		Values map[string]interface{} `validate:"item:set-trim"`
+	}
+
+	obj := &example{
+		Items:  map[string]string{"a": " x ", "b": "y"},
+		Keys:   map[string]int{"a": 1, "b": 2},
+		Values: map[string]interface{}{"a": " z ", "b": 1},
+	}
+
+	if errs := NewValidator().Validate(obj); len(errs) != 0 {
+		t.Fatalf("unexpected errors %v", errs)
+	}
+
+	expected := &example{
+		Items:  map[string]string{"a": "x", "b": "y"},
+		Keys:   map[string]int{"A": 1, "B": 2},
+		Values: map[string]interface{}{"a": "z", "b": 1},
+	}
+
+	if !reflect.DeepEqual(obj, expected) {
+		t.Errorf("expected %v, got %v", expected, obj)
+	}
+}
+
+func TestMapKeyCollision(t *testing.T) {
+	type example struct {
+		Keys map[string]string `validate:"key:set-upper"`
+	}
+
+	obj := &example{Keys: map[string]string{"a": " x ", "A": "y "}}
+	errs := NewValidator().Validate(obj)
+
+	if len(errs) != 1 || !isErrorCode(errs[0], ErrorDuplicatedKey) {
+		t.Errorf("expected the error [%s], got %v", ErrorDuplicatedKey, errs)
+	}
+
+	expected := map[string]string{"a": " x ", "A": "y "}
+	if !reflect.DeepEqual(obj.Keys, expected) {
+		t.Errorf("expected %v, got %v", expected, obj.Keys)
+	}
+}
+
+func TestMapValidationIsReadOnly(t *testing.T) {
+	type example struct {
+		Items map[string]string `validate:"item:size=1, key:size=1"`
+	}
+
+	obj := &example{Items: map[string]string{"a": "x", "b": "y", "c": "z"}}
+
+	// a validation without set tags must not write on the shared map (go test -race)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errs := NewValidator().Validate(obj); len(errs) != 0 {
+				t.Errorf("unexpected errors %v", errs)
+			}
+		}()
+	}
+	wg.Wait()
+}